@@ -0,0 +1,67 @@
+package autodiff
+
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Derivative receives a function written against dual numbers and the value x. It returns the value of the function
+// at x and its exact first derivative.
+func Derivative(f func(Dual) Dual, x float64) (float64, float64) {
+	r := f(Variable(x))
+	return r.Value, r.Deriv
+}
+
+// Gradient receives a scalar function of dual numbers and the point x. It returns the exact gradient of the function
+// at x, and the error (if there is any). Every coordinate costs one forward pass of the function.
+func Gradient(f func([]Dual) Dual, x numericalgo.Vector) (numericalgo.Vector, error) {
+	n := x.Dim()
+	if n == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+
+	g := make(numericalgo.Vector, n)
+	for i := 0; i < n; i++ {
+		g[i] = f(seed(x, i)).Deriv
+	}
+	return g, nil
+}
+
+// Jacobian receives a vector function of dual numbers and the point x. It returns the exact Jacobian matrix
+// J[i][j] = df_i/dx_j of the function at x, and the error (if there is any). Every column costs one forward pass of
+// the function.
+func Jacobian(f func([]Dual) []Dual, x numericalgo.Vector) (numericalgo.Matrix, error) {
+	n := x.Dim()
+	if n == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+
+	var j numericalgo.Matrix
+	for col := 0; col < n; col++ {
+		fx := f(seed(x, col))
+		if j == nil {
+			j = make(numericalgo.Matrix, len(fx))
+			for row := range j {
+				j[row] = make(numericalgo.Vector, n)
+			}
+		}
+		if len(fx) != len(j) {
+			return nil, fmt.Errorf("Function output sizes do not match")
+		}
+		for row := range fx {
+			j[row][col] = fx[row].Deriv
+		}
+	}
+	return j, nil
+}
+
+// seed returns x as dual numbers, with the derivative of the i-th coordinate set to 1 and all others to 0.
+func seed(x numericalgo.Vector, i int) []Dual {
+	d := make([]Dual, x.Dim())
+	for k, v := range x {
+		d[k] = Constant(v)
+	}
+	d[i].Deriv = 1
+	return d
+}
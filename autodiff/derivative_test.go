@@ -0,0 +1,102 @@
+package autodiff_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/autodiff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerivative(t *testing.T) {
+	// f(x) = cos(x^2 - 2), f'(x) = -2x sin(x^2 - 2)
+	f := func(x autodiff.Dual) autodiff.Dual {
+		return autodiff.Cos(x.Mul(x).AddConst(-2))
+	}
+
+	value, deriv := autodiff.Derivative(f, 1)
+	assert.InDelta(t, math.Cos(-1), value, 1e-15)
+	assert.InDelta(t, 2*math.Sin(1), deriv, 1e-15)
+}
+
+func TestGradient(t *testing.T) {
+	// Rosenbrock function (1-x)^2 + 100(y-x^2)^2.
+	f := func(v []autodiff.Dual) autodiff.Dual {
+		a := autodiff.Constant(1).Sub(v[0])
+		b := v[1].Sub(v[0].Mul(v[0]))
+		return a.Mul(a).Add(b.Mul(b).Scale(100))
+	}
+
+	cases := map[string]struct {
+		x              numericalgo.Vector
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"away from the minimum": {
+			x:              numericalgo.Vector{-1.2, 1},
+			expectedResult: numericalgo.Vector{-215.6, -88},
+			expectedError:  nil,
+		},
+		"at the minimum": {
+			x:              numericalgo.Vector{1, 1},
+			expectedResult: numericalgo.Vector{0, 0},
+			expectedError:  nil,
+		},
+		"empty point": {
+			x:              numericalgo.Vector{},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Vector cannot be empty"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := autodiff.Gradient(f, c.x)
+			assert.Equal(t, c.expectedError, err)
+			if c.expectedResult == nil {
+				assert.Nil(t, result)
+			} else {
+				assert.InDeltaSlice(t, c.expectedResult, result, 1e-12)
+			}
+		})
+	}
+}
+
+func TestJacobian(t *testing.T) {
+	// f(x, y, z) = (x*y, sin(z) + x, exp(y*z))
+	f := func(v []autodiff.Dual) []autodiff.Dual {
+		return []autodiff.Dual{v[0].Mul(v[1]), autodiff.Sin(v[2]).Add(v[0]), autodiff.Exp(v[1].Mul(v[2]))}
+	}
+	x := numericalgo.Vector{2, 0.5, -1}
+	e := math.Exp(-0.5)
+	expected := numericalgo.Matrix{
+		{0.5, 2, 0},
+		{1, 0, math.Cos(-1)},
+		{0, -e, 0.5 * e},
+	}
+
+	result, err := autodiff.Jacobian(f, x)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(result))
+	for i := range expected {
+		assert.InDeltaSlice(t, expected[i], result[i], 1e-15)
+	}
+}
+
+func TestJacobianErrors(t *testing.T) {
+	calls := 0
+	f := func(v []autodiff.Dual) []autodiff.Dual {
+		calls++
+		if calls > 1 {
+			return v[:1]
+		}
+		return v
+	}
+	_, err := autodiff.Jacobian(f, numericalgo.Vector{1, 2})
+	assert.Equal(t, fmt.Errorf("Function output sizes do not match"), err)
+
+	_, err = autodiff.Jacobian(f, numericalgo.Vector{})
+	assert.Equal(t, fmt.Errorf("Vector cannot be empty"), err)
+}
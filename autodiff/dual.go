@@ -0,0 +1,143 @@
+// Package autodiff implements automatic differentiation. Dual numbers give exact derivatives in forward mode, and a
+// Tape gives exact gradients in reverse mode.
+package autodiff
+
+import "math"
+
+// Dual is a dual number Value + Deriv*e with e^2 = 0. Evaluating a function on Dual{x, 1} carries its derivative at x
+// along in the Deriv part, exactly and without choosing a step size.
+type Dual struct {
+	Value float64
+	Deriv float64
+}
+
+// Variable returns the dual number of the independent variable x, whose derivative is 1.
+func Variable(x float64) Dual {
+	return Dual{Value: x, Deriv: 1}
+}
+
+// Constant returns the dual number of the constant c, whose derivative is 0.
+func Constant(c float64) Dual {
+	return Dual{Value: c}
+}
+
+// Add returns the sum a + b.
+func (a Dual) Add(b Dual) Dual {
+	return Dual{Value: a.Value + b.Value, Deriv: a.Deriv + b.Deriv}
+}
+
+// Sub returns the difference a - b.
+func (a Dual) Sub(b Dual) Dual {
+	return Dual{Value: a.Value - b.Value, Deriv: a.Deriv - b.Deriv}
+}
+
+// Mul returns the product a * b.
+func (a Dual) Mul(b Dual) Dual {
+	return Dual{Value: a.Value * b.Value, Deriv: a.Deriv*b.Value + a.Value*b.Deriv}
+}
+
+// Div returns the quotient a / b.
+func (a Dual) Div(b Dual) Dual {
+	return Dual{Value: a.Value / b.Value, Deriv: (a.Deriv*b.Value - a.Value*b.Deriv) / (b.Value * b.Value)}
+}
+
+// Neg returns -a.
+func (a Dual) Neg() Dual {
+	return Dual{Value: -a.Value, Deriv: -a.Deriv}
+}
+
+// AddConst returns a + c for the constant c.
+func (a Dual) AddConst(c float64) Dual {
+	return Dual{Value: a.Value + c, Deriv: a.Deriv}
+}
+
+// Scale returns c * a for the constant c.
+func (a Dual) Scale(c float64) Dual {
+	return Dual{Value: c * a.Value, Deriv: c * a.Deriv}
+}
+
+// Sin returns sin(a).
+func Sin(a Dual) Dual {
+	s, c := math.Sincos(a.Value)
+	return Dual{Value: s, Deriv: c * a.Deriv}
+}
+
+// Cos returns cos(a).
+func Cos(a Dual) Dual {
+	s, c := math.Sincos(a.Value)
+	return Dual{Value: c, Deriv: -s * a.Deriv}
+}
+
+// Tan returns tan(a).
+func Tan(a Dual) Dual {
+	t := math.Tan(a.Value)
+	return Dual{Value: t, Deriv: (1 + t*t) * a.Deriv}
+}
+
+// Atan returns atan(a).
+func Atan(a Dual) Dual {
+	return Dual{Value: math.Atan(a.Value), Deriv: a.Deriv / (1 + a.Value*a.Value)}
+}
+
+// Sinh returns sinh(a).
+func Sinh(a Dual) Dual {
+	return Dual{Value: math.Sinh(a.Value), Deriv: math.Cosh(a.Value) * a.Deriv}
+}
+
+// Cosh returns cosh(a).
+func Cosh(a Dual) Dual {
+	return Dual{Value: math.Cosh(a.Value), Deriv: math.Sinh(a.Value) * a.Deriv}
+}
+
+// Tanh returns tanh(a).
+func Tanh(a Dual) Dual {
+	t := math.Tanh(a.Value)
+	return Dual{Value: t, Deriv: (1 - t*t) * a.Deriv}
+}
+
+// Exp returns e^a.
+func Exp(a Dual) Dual {
+	e := math.Exp(a.Value)
+	return Dual{Value: e, Deriv: e * a.Deriv}
+}
+
+// Log returns the natural logarithm of a.
+func Log(a Dual) Dual {
+	return Dual{Value: math.Log(a.Value), Deriv: a.Deriv / a.Value}
+}
+
+// Sqrt returns the square root of a.
+func Sqrt(a Dual) Dual {
+	s := math.Sqrt(a.Value)
+	return Dual{Value: s, Deriv: a.Deriv / (2 * s)}
+}
+
+// Abs returns |a|. Its derivative at zero is taken to be zero.
+func Abs(a Dual) Dual {
+	switch {
+	case a.Value > 0:
+		return a
+	case a.Value < 0:
+		return a.Neg()
+	default:
+		return Dual{}
+	}
+}
+
+// PowConst returns a^p for the constant exponent p.
+func PowConst(a Dual, p float64) Dual {
+	if p == 0 {
+		return Constant(1)
+	}
+	return Dual{Value: math.Pow(a.Value, p), Deriv: p * math.Pow(a.Value, p-1) * a.Deriv}
+}
+
+// Pow returns a^b, where both the base and the exponent may depend on the variable. The base must be positive
+// wherever the exponent is not constant.
+func Pow(a, b Dual) Dual {
+	if b.Deriv == 0 {
+		return PowConst(a, b.Value)
+	}
+	v := math.Pow(a.Value, b.Value)
+	return Dual{Value: v, Deriv: v * (b.Deriv*math.Log(a.Value) + b.Value*a.Deriv/a.Value)}
+}
@@ -0,0 +1,99 @@
+package autodiff_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/autodiff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDualArithmetic(t *testing.T) {
+	x := autodiff.Variable(3)
+	c := autodiff.Constant(2)
+
+	cases := map[string]struct {
+		result   autodiff.Dual
+		expected autodiff.Dual
+	}{
+		"add":       {result: x.Add(c), expected: autodiff.Dual{Value: 5, Deriv: 1}},
+		"sub":       {result: c.Sub(x), expected: autodiff.Dual{Value: -1, Deriv: -1}},
+		"mul":       {result: x.Mul(x), expected: autodiff.Dual{Value: 9, Deriv: 6}},
+		"div":       {result: c.Div(x), expected: autodiff.Dual{Value: 2.0 / 3, Deriv: -2.0 / 9}},
+		"neg":       {result: x.Neg(), expected: autodiff.Dual{Value: -3, Deriv: -1}},
+		"add const": {result: x.AddConst(4), expected: autodiff.Dual{Value: 7, Deriv: 1}},
+		"scale":     {result: x.Scale(5), expected: autodiff.Dual{Value: 15, Deriv: 5}},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, c.expected.Value, c.result.Value, 1e-15)
+			assert.InDelta(t, c.expected.Deriv, c.result.Deriv, 1e-15)
+		})
+	}
+}
+
+func TestDualFunctions(t *testing.T) {
+	x := 0.7
+
+	cases := map[string]struct {
+		f             func(autodiff.Dual) autodiff.Dual
+		expectedValue float64
+		expectedDeriv float64
+	}{
+		"sin":  {f: autodiff.Sin, expectedValue: math.Sin(x), expectedDeriv: math.Cos(x)},
+		"cos":  {f: autodiff.Cos, expectedValue: math.Cos(x), expectedDeriv: -math.Sin(x)},
+		"tan":  {f: autodiff.Tan, expectedValue: math.Tan(x), expectedDeriv: 1 / math.Pow(math.Cos(x), 2)},
+		"atan": {f: autodiff.Atan, expectedValue: math.Atan(x), expectedDeriv: 1 / (1 + x*x)},
+		"sinh": {f: autodiff.Sinh, expectedValue: math.Sinh(x), expectedDeriv: math.Cosh(x)},
+		"cosh": {f: autodiff.Cosh, expectedValue: math.Cosh(x), expectedDeriv: math.Sinh(x)},
+		"tanh": {f: autodiff.Tanh, expectedValue: math.Tanh(x), expectedDeriv: 1 / math.Pow(math.Cosh(x), 2)},
+		"exp":  {f: autodiff.Exp, expectedValue: math.Exp(x), expectedDeriv: math.Exp(x)},
+		"log":  {f: autodiff.Log, expectedValue: math.Log(x), expectedDeriv: 1 / x},
+		"sqrt": {f: autodiff.Sqrt, expectedValue: math.Sqrt(x), expectedDeriv: 0.5 / math.Sqrt(x)},
+		"abs of a negative value": {
+			f: func(d autodiff.Dual) autodiff.Dual {
+				return autodiff.Abs(d.Neg())
+			},
+			expectedValue: x,
+			expectedDeriv: 1,
+		},
+		"constant power": {
+			f: func(d autodiff.Dual) autodiff.Dual {
+				return autodiff.PowConst(d, 2.5)
+			},
+			expectedValue: math.Pow(x, 2.5),
+			expectedDeriv: 2.5 * math.Pow(x, 1.5),
+		},
+		"variable power": {
+			f: func(d autodiff.Dual) autodiff.Dual {
+				return autodiff.Pow(d, d)
+			},
+			expectedValue: math.Pow(x, x),
+			expectedDeriv: math.Pow(x, x) * (math.Log(x) + 1),
+		},
+		"power with a constant exponent": {
+			f: func(d autodiff.Dual) autodiff.Dual {
+				return autodiff.Pow(d, autodiff.Constant(3))
+			},
+			expectedValue: x * x * x,
+			expectedDeriv: 3 * x * x,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := c.f(autodiff.Variable(x))
+			assert.InEpsilon(t, c.expectedValue, r.Value, 1e-14)
+			assert.InEpsilon(t, c.expectedDeriv, r.Deriv, 1e-14)
+		})
+	}
+}
+
+func TestPowAtZero(t *testing.T) {
+	r := autodiff.PowConst(autodiff.Variable(0), 2)
+	assert.Equal(t, autodiff.Dual{Value: 0, Deriv: 0}, r)
+
+	r = autodiff.PowConst(autodiff.Variable(0), 0)
+	assert.Equal(t, autodiff.Dual{Value: 1, Deriv: 0}, r)
+}
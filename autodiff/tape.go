@@ -0,0 +1,305 @@
+package autodiff
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Tape records the operations performed on tracked variables, so that the gradient of a result with respect to all
+// the variables can be computed afterwards in a single backward sweep (reverse-mode automatic differentiation). The
+// cost of the sweep is proportional to the number of recorded operations, independent of the number of variables.
+type Tape struct {
+	nodes []node
+	vars  []int
+	err   error
+}
+
+// node is a recorded operation: the indices of up to two operands and the partial derivatives of the result with
+// respect to them.
+type node struct {
+	parents  [2]int
+	partials [2]float64
+	arity    int
+}
+
+// Var is a value tracked by a tape. Vars are created by Tape.Variable or Tape.Constant and combined with their
+// methods, which cover the same operations as the functions of Dual, so code can be moved between the two modes.
+// A Var without a tape, such as the zero value, is an untracked constant. Combining Vars of different tapes is
+// recorded as an error, which Gradient of either tape returns.
+type Var struct {
+	Value float64
+	tape  *Tape
+	index int
+}
+
+// NewTape returns the pointer to a new, empty Tape.
+func NewTape() *Tape {
+	return &Tape{}
+}
+
+// Variable records a new independent variable with the value x and returns it. The gradient returned by Gradient has
+// one element per variable, in the order the variables were created.
+func (t *Tape) Variable(x float64) Var {
+	v := t.push(x, node{})
+	t.vars = append(t.vars, v.index)
+	return v
+}
+
+// Variables records one independent variable per element of x and returns them.
+func (t *Tape) Variables(x numericalgo.Vector) []Var {
+	vs := make([]Var, x.Dim())
+	for i, val := range x {
+		vs[i] = t.Variable(val)
+	}
+	return vs
+}
+
+// Constant records the constant c, whose gradient is not tracked, and returns it.
+func (t *Tape) Constant(c float64) Var {
+	return t.push(c, node{})
+}
+
+// Len returns the number of operations recorded on the tape.
+func (t *Tape) Len() int {
+	return len(t.nodes)
+}
+
+// Reset clears the tape and its error, so that it can record a new evaluation while reusing its memory. Vars recorded
+// before the reset must not be used afterwards.
+func (t *Tape) Reset() {
+	t.nodes = t.nodes[:0]
+	t.vars = t.vars[:0]
+	t.err = nil
+}
+
+// Gradient receives a Var recorded on the tape, and returns the gradient of its value with respect to all the
+// variables of the tape and the error (if there is any). The adjoints are propagated backwards through the recorded
+// operations once. The gradient of a constant without a tape is zero.
+func (t *Tape) Gradient(out Var) (numericalgo.Vector, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	if out.tape == nil {
+		return make(numericalgo.Vector, len(t.vars)), nil
+	}
+	if out.tape != t {
+		return nil, fmt.Errorf("Var does not belong to the tape")
+	}
+
+	adj := make([]float64, out.index+1)
+	adj[out.index] = 1
+	for i := out.index; i >= 0; i-- {
+		if adj[i] == 0 {
+			continue
+		}
+		n := t.nodes[i]
+		for k := 0; k < n.arity; k++ {
+			adj[n.parents[k]] += n.partials[k] * adj[i]
+		}
+	}
+
+	g := make(numericalgo.Vector, len(t.vars))
+	for i, idx := range t.vars {
+		if idx <= out.index {
+			g[i] = adj[idx]
+		}
+	}
+	return g, nil
+}
+
+// push appends the node with the given value to the tape and returns its Var. Without a tape, the value is returned
+// as a constant.
+func (t *Tape) push(value float64, n node) Var {
+	if t == nil {
+		return Var{Value: value}
+	}
+	t.nodes = append(t.nodes, n)
+	return Var{Value: value, tape: t, index: len(t.nodes) - 1}
+}
+
+// unary records the result of a one-operand operation with the partial derivative d.
+func (a Var) unary(value, d float64) Var {
+	return a.tape.push(value, node{parents: [2]int{a.index}, partials: [2]float64{d}, arity: 1})
+}
+
+// binary records the result of a two-operand operation with the partial derivatives da and db. An operand without a
+// tape is treated as a constant. If the operands belong to different tapes, the error is recorded on both of them and
+// the result is returned as a constant.
+func (a Var) binary(b Var, value, da, db float64) Var {
+	switch {
+	case a.tape == b.tape:
+		return a.tape.push(value, node{parents: [2]int{a.index, b.index}, partials: [2]float64{da, db}, arity: 2})
+	case b.tape == nil:
+		return a.unary(value, da)
+	case a.tape == nil:
+		return b.unary(value, db)
+	}
+
+	err := fmt.Errorf("Vars belong to different tapes")
+	if a.tape.err == nil {
+		a.tape.err = err
+	}
+	if b.tape.err == nil {
+		b.tape.err = err
+	}
+	return Var{Value: value}
+}
+
+// Add returns the sum a + b.
+func (a Var) Add(b Var) Var {
+	return a.binary(b, a.Value+b.Value, 1, 1)
+}
+
+// Sub returns the difference a - b.
+func (a Var) Sub(b Var) Var {
+	return a.binary(b, a.Value-b.Value, 1, -1)
+}
+
+// Mul returns the product a * b.
+func (a Var) Mul(b Var) Var {
+	return a.binary(b, a.Value*b.Value, b.Value, a.Value)
+}
+
+// Div returns the quotient a / b.
+func (a Var) Div(b Var) Var {
+	return a.binary(b, a.Value/b.Value, 1/b.Value, -a.Value/(b.Value*b.Value))
+}
+
+// Neg returns -a.
+func (a Var) Neg() Var {
+	return a.unary(-a.Value, -1)
+}
+
+// AddConst returns a + c for the constant c.
+func (a Var) AddConst(c float64) Var {
+	return a.unary(a.Value+c, 1)
+}
+
+// Scale returns c * a for the constant c.
+func (a Var) Scale(c float64) Var {
+	return a.unary(c*a.Value, c)
+}
+
+// Square returns a^2.
+func (a Var) Square() Var {
+	return a.unary(a.Value*a.Value, 2*a.Value)
+}
+
+// Sin returns sin(a).
+func (a Var) Sin() Var {
+	s, c := math.Sincos(a.Value)
+	return a.unary(s, c)
+}
+
+// Cos returns cos(a).
+func (a Var) Cos() Var {
+	s, c := math.Sincos(a.Value)
+	return a.unary(c, -s)
+}
+
+// Tan returns tan(a).
+func (a Var) Tan() Var {
+	t := math.Tan(a.Value)
+	return a.unary(t, 1+t*t)
+}
+
+// Atan returns atan(a).
+func (a Var) Atan() Var {
+	return a.unary(math.Atan(a.Value), 1/(1+a.Value*a.Value))
+}
+
+// Sinh returns sinh(a).
+func (a Var) Sinh() Var {
+	return a.unary(math.Sinh(a.Value), math.Cosh(a.Value))
+}
+
+// Cosh returns cosh(a).
+func (a Var) Cosh() Var {
+	return a.unary(math.Cosh(a.Value), math.Sinh(a.Value))
+}
+
+// Tanh returns tanh(a).
+func (a Var) Tanh() Var {
+	t := math.Tanh(a.Value)
+	return a.unary(t, 1-t*t)
+}
+
+// Exp returns e^a.
+func (a Var) Exp() Var {
+	e := math.Exp(a.Value)
+	return a.unary(e, e)
+}
+
+// Log returns the natural logarithm of a.
+func (a Var) Log() Var {
+	return a.unary(math.Log(a.Value), 1/a.Value)
+}
+
+// Sqrt returns the square root of a.
+func (a Var) Sqrt() Var {
+	s := math.Sqrt(a.Value)
+	return a.unary(s, 1/(2*s))
+}
+
+// Abs returns |a|. Its derivative at zero is taken to be zero.
+func (a Var) Abs() Var {
+	switch {
+	case a.Value > 0:
+		return a.unary(a.Value, 1)
+	case a.Value < 0:
+		return a.unary(-a.Value, -1)
+	default:
+		return a.unary(0, 0)
+	}
+}
+
+// PowConst returns a^p for the constant exponent p.
+func (a Var) PowConst(p float64) Var {
+	if p == 0 {
+		return a.unary(1, 0)
+	}
+	return a.unary(math.Pow(a.Value, p), p*math.Pow(a.Value, p-1))
+}
+
+// Pow returns a^b, where both the base and the exponent may be tracked. The base must be positive wherever the
+// gradient with respect to the exponent is needed; for a non-positive base the partial derivative with respect to the
+// exponent is taken to be zero, which is exact when the exponent is a constant.
+func (a Var) Pow(b Var) Var {
+	v := math.Pow(a.Value, b.Value)
+	var db float64
+	if a.Value > 0 {
+		db = v * math.Log(a.Value)
+	}
+	var da float64
+	if b.Value != 0 {
+		da = b.Value * math.Pow(a.Value, b.Value-1)
+	}
+	return a.binary(b, v, da, db)
+}
+
+// Sum returns the sum of the Vars. The sum of no Vars is the constant zero.
+func Sum(vs []Var) Var {
+	if len(vs) == 0 {
+		return Var{}
+	}
+
+	s := vs[0]
+	for _, v := range vs[1:] {
+		s = s.Add(v)
+	}
+	return s
+}
+
+// ReverseGradient receives a scalar function of tracked variables and the point x. It records one evaluation of the
+// function on a new tape and returns the exact gradient at x, and the error (if there is any). Unlike Gradient, the
+// cost does not grow with the number of variables.
+func ReverseGradient(f func([]Var) Var, x numericalgo.Vector) (numericalgo.Vector, error) {
+	if x.Dim() == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+
+	t := NewTape()
+	return t.Gradient(f(t.Variables(x)))
+}
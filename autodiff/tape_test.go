@@ -0,0 +1,245 @@
+package autodiff_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/autodiff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTapeOperations(t *testing.T) {
+	x := 0.7
+	y := 1.3
+
+	cases := map[string]struct {
+		f             func(x, y autodiff.Var) autodiff.Var
+		expectedValue float64
+		expectedGrad  numericalgo.Vector
+	}{
+		"add":  {f: autodiff.Var.Add, expectedValue: x + y, expectedGrad: numericalgo.Vector{1, 1}},
+		"sub":  {f: autodiff.Var.Sub, expectedValue: x - y, expectedGrad: numericalgo.Vector{1, -1}},
+		"mul":  {f: autodiff.Var.Mul, expectedValue: x * y, expectedGrad: numericalgo.Vector{y, x}},
+		"div":  {f: autodiff.Var.Div, expectedValue: x / y, expectedGrad: numericalgo.Vector{1 / y, -x / (y * y)}},
+		"neg":  {f: func(a, b autodiff.Var) autodiff.Var { return a.Neg() }, expectedValue: -x, expectedGrad: numericalgo.Vector{-1, 0}},
+		"sin":  {f: func(a, b autodiff.Var) autodiff.Var { return a.Sin() }, expectedValue: math.Sin(x), expectedGrad: numericalgo.Vector{math.Cos(x), 0}},
+		"cos":  {f: func(a, b autodiff.Var) autodiff.Var { return b.Cos() }, expectedValue: math.Cos(y), expectedGrad: numericalgo.Vector{0, -math.Sin(y)}},
+		"tanh": {f: func(a, b autodiff.Var) autodiff.Var { return a.Tanh() }, expectedValue: math.Tanh(x), expectedGrad: numericalgo.Vector{1 - math.Pow(math.Tanh(x), 2), 0}},
+		"exp":  {f: func(a, b autodiff.Var) autodiff.Var { return a.Exp() }, expectedValue: math.Exp(x), expectedGrad: numericalgo.Vector{math.Exp(x), 0}},
+		"log":  {f: func(a, b autodiff.Var) autodiff.Var { return a.Log() }, expectedValue: math.Log(x), expectedGrad: numericalgo.Vector{1 / x, 0}},
+		"sqrt": {f: func(a, b autodiff.Var) autodiff.Var { return b.Sqrt() }, expectedValue: math.Sqrt(y), expectedGrad: numericalgo.Vector{0, 0.5 / math.Sqrt(y)}},
+		"tan":  {f: func(a, b autodiff.Var) autodiff.Var { return a.Tan() }, expectedValue: math.Tan(x), expectedGrad: numericalgo.Vector{1 / math.Pow(math.Cos(x), 2), 0}},
+		"atan": {f: func(a, b autodiff.Var) autodiff.Var { return b.Atan() }, expectedValue: math.Atan(y), expectedGrad: numericalgo.Vector{0, 1 / (1 + y*y)}},
+		"sinh": {f: func(a, b autodiff.Var) autodiff.Var { return a.Sinh() }, expectedValue: math.Sinh(x), expectedGrad: numericalgo.Vector{math.Cosh(x), 0}},
+		"cosh": {f: func(a, b autodiff.Var) autodiff.Var { return a.Cosh() }, expectedValue: math.Cosh(x), expectedGrad: numericalgo.Vector{math.Sinh(x), 0}},
+		"abs of a negative value": {
+			f:             func(a, b autodiff.Var) autodiff.Var { return a.Sub(b).Abs() },
+			expectedValue: y - x,
+			expectedGrad:  numericalgo.Vector{-1, 1},
+		},
+		"abs of a positive value": {
+			f:             func(a, b autodiff.Var) autodiff.Var { return b.Sub(a).Abs() },
+			expectedValue: y - x,
+			expectedGrad:  numericalgo.Vector{-1, 1},
+		},
+		"variable power": {
+			f:             autodiff.Var.Pow,
+			expectedValue: math.Pow(x, y),
+			expectedGrad:  numericalgo.Vector{y * math.Pow(x, y-1), math.Pow(x, y) * math.Log(x)},
+		},
+		"constant power": {
+			f:             func(a, b autodiff.Var) autodiff.Var { return a.PowConst(2.5) },
+			expectedValue: math.Pow(x, 2.5),
+			expectedGrad:  numericalgo.Vector{2.5 * math.Pow(x, 1.5), 0},
+		},
+		"square, scale and shift": {
+			f:             func(a, b autodiff.Var) autodiff.Var { return a.Square().Scale(3).AddConst(1) },
+			expectedValue: 3*x*x + 1,
+			expectedGrad:  numericalgo.Vector{6 * x, 0},
+		},
+		"reused variable": {
+			f:             func(a, b autodiff.Var) autodiff.Var { return a.Mul(b).Add(a.Sin().Mul(a)) },
+			expectedValue: x*y + x*math.Sin(x),
+			expectedGrad:  numericalgo.Vector{y + math.Sin(x) + x*math.Cos(x), x},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			tape := autodiff.NewTape()
+			out := c.f(tape.Variable(x), tape.Variable(y))
+			assert.InEpsilon(t, c.expectedValue, out.Value, 1e-14)
+
+			g, err := tape.Gradient(out)
+			assert.Nil(t, err)
+			assert.InDeltaSlice(t, c.expectedGrad, g, 1e-14)
+		})
+	}
+}
+
+func TestTapeMatchesDual(t *testing.T) {
+	// The same function written for both modes gives the same gradient.
+	x := numericalgo.Vector{0.4, 1.7}
+	reverse := func(v []autodiff.Var) autodiff.Var {
+		return v[0].Tan().Mul(v[1].Atan()).Add(v[0].Sub(v[1]).Abs()).Add(v[1].Pow(v[0]))
+	}
+	forward := func(v []autodiff.Dual) autodiff.Dual {
+		return autodiff.Tan(v[0]).Mul(autodiff.Atan(v[1])).Add(autodiff.Abs(v[0].Sub(v[1]))).Add(autodiff.Pow(v[1], v[0]))
+	}
+
+	gr, err := autodiff.ReverseGradient(reverse, x)
+	assert.Nil(t, err)
+	gf, err := autodiff.Gradient(forward, x)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, gf, gr, 1e-14)
+}
+
+func TestTapePowAtZero(t *testing.T) {
+	tape := autodiff.NewTape()
+	x := tape.Variable(0)
+	g, err := tape.Gradient(x.Pow(tape.Constant(2)))
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Vector{0}, g)
+}
+
+func TestTapeConstants(t *testing.T) {
+	tape := autodiff.NewTape()
+	x := tape.Variable(2)
+	c := tape.Constant(5)
+	out := x.Mul(c).Add(c)
+	assert.Equal(t, 15.0, out.Value)
+
+	g, err := tape.Gradient(out)
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Vector{5}, g)
+}
+
+func TestTapeIntermediateGradient(t *testing.T) {
+	tape := autodiff.NewTape()
+	x := tape.Variable(3)
+	y := x.Square()
+	tape.Variable(4)
+	z := y.Mul(x)
+
+	g, err := tape.Gradient(y)
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Vector{6, 0}, g)
+
+	g, err = tape.Gradient(z)
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Vector{27, 0}, g)
+}
+
+func TestTapeReset(t *testing.T) {
+	tape := autodiff.NewTape()
+	tape.Variable(1).Exp()
+	assert.Equal(t, 2, tape.Len())
+
+	tape.Reset()
+	assert.Equal(t, 0, tape.Len())
+	x := tape.Variable(2)
+	g, err := tape.Gradient(x.Square())
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Vector{4}, g)
+}
+
+func TestTapeErrors(t *testing.T) {
+	a := autodiff.NewTape()
+	b := autodiff.NewTape()
+	x := a.Variable(1)
+
+	_, err := b.Gradient(x)
+	assert.Equal(t, fmt.Errorf("Var does not belong to the tape"), err)
+
+	y := x.Add(b.Variable(2))
+	assert.Equal(t, 3.0, y.Value)
+
+	_, err = a.Gradient(x)
+	assert.Equal(t, fmt.Errorf("Vars belong to different tapes"), err)
+	_, err = b.Gradient(y)
+	assert.Equal(t, fmt.Errorf("Vars belong to different tapes"), err)
+
+	a.Reset()
+	_, err = a.Gradient(a.Variable(1))
+	assert.Nil(t, err)
+}
+
+func TestTapeUntrackedConstants(t *testing.T) {
+	tape := autodiff.NewTape()
+	x := tape.Variable(2)
+
+	// The zero value of Var is the constant zero.
+	var z autodiff.Var
+	out := x.Mul(z.AddConst(3)).Add(z)
+	assert.Equal(t, 6.0, out.Value)
+	g, err := tape.Gradient(out)
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Vector{3}, g)
+
+	empty := autodiff.Sum(nil)
+	assert.Equal(t, 0.0, empty.Value)
+	g, err = tape.Gradient(empty)
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Vector{0}, g)
+}
+
+func TestReverseGradient(t *testing.T) {
+	// Rosenbrock function (1-x)^2 + 100(y-x^2)^2.
+	rosenbrock := func(v []autodiff.Var) autodiff.Var {
+		return v[0].Neg().AddConst(1).Square().Add(v[1].Sub(v[0].Square()).Square().Scale(100))
+	}
+
+	g, err := autodiff.ReverseGradient(rosenbrock, numericalgo.Vector{-1.2, 1})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, numericalgo.Vector{-215.6, -88}, g, 1e-12)
+
+	_, err = autodiff.ReverseGradient(rosenbrock, numericalgo.Vector{})
+	assert.Equal(t, fmt.Errorf("Vector cannot be empty"), err)
+}
+
+func TestReverseGradientLeastSquares(t *testing.T) {
+	// Sum of squared residuals of the model y = sum_k p_k * x^k with many parameters, compared with the forward mode.
+	n := 200
+	x := make(numericalgo.Vector, 30)
+	y := make(numericalgo.Vector, 30)
+	for i := range x {
+		x[i] = float64(i) / 30
+		y[i] = math.Sin(3 * x[i])
+	}
+	p := make(numericalgo.Vector, n)
+	for k := range p {
+		p[k] = 1 / float64(k+1)
+	}
+
+	reverse := func(v []autodiff.Var) autodiff.Var {
+		var residuals []autodiff.Var
+		for i := range x {
+			model := v[n-1]
+			for k := n - 2; k >= 0; k-- {
+				model = model.Scale(x[i]).Add(v[k])
+			}
+			residuals = append(residuals, model.AddConst(-y[i]).Square())
+		}
+		return autodiff.Sum(residuals)
+	}
+	forward := func(v []autodiff.Dual) autodiff.Dual {
+		var s autodiff.Dual
+		for i := range x {
+			model := v[n-1]
+			for k := n - 2; k >= 0; k-- {
+				model = model.Scale(x[i]).Add(v[k])
+			}
+			r := model.AddConst(-y[i])
+			s = s.Add(r.Mul(r))
+		}
+		return s
+	}
+
+	gr, err := autodiff.ReverseGradient(reverse, p)
+	assert.Nil(t, err)
+	gf, err := autodiff.Gradient(forward, p)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, gf, gr, 1e-10)
+}
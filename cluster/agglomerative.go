@@ -0,0 +1,167 @@
+package cluster
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Linkage is the rule which defines the distance between two clusters in agglomerative clustering.
+type Linkage int
+
+const (
+	// Single linkage uses the distance between the closest observations of the two clusters.
+	Single Linkage = iota
+	// Complete linkage uses the distance between the farthest observations of the two clusters.
+	Complete
+	// Average linkage uses the average distance between the observations of the two clusters (UPGMA).
+	Average
+	// Ward linkage merges the clusters whose union increases the inertia the least.
+	Ward
+)
+
+// Agglomerative performs hierarchical agglomerative clustering of the rows of a data matrix. Starting with every
+// observation in its own cluster, it repeatedly merges the two closest clusters under the linkage until K clusters
+// are left. Distances between merged clusters are updated with the Lance-Williams formula, so every linkage runs in
+// O(n^2) memory and O(n^3) time.
+//
+// After fitting, Labels holds the cluster of every observation, numbered in the order of their first appearance,
+// Centroids holds the means of the clusters as rows, and Inertia holds the sum of squared distances of the
+// observations to their centroids.
+type Agglomerative struct {
+	K         int
+	Linkage   Linkage
+	Labels    []int
+	Centroids numericalgo.Matrix
+	Inertia   float64
+}
+
+// NewAgglomerative receives the number of clusters and the linkage, and returns the pointer to the new
+// Agglomerative type.
+func NewAgglomerative(k int, linkage Linkage) *Agglomerative {
+	a := &Agglomerative{K: k, Linkage: linkage}
+	return a
+}
+
+// Fit receives the data matrix whose rows are observations, clusters them and stores the labels, centroids and
+// inertia. It returns the error if something went wrong.
+func (a *Agglomerative) Fit(x numericalgo.Matrix) error {
+	if err := checkData(x); err != nil {
+		return err
+	}
+
+	n := len(x)
+	if a.K < 1 || a.K > n {
+		return fmt.Errorf("Number of clusters must be between 1 and the number of observations")
+	}
+
+	if a.Linkage < Single || a.Linkage > Ward {
+		return fmt.Errorf("Unknown linkage")
+	}
+
+	// Ward linkage works with squared Euclidean distances, the others with Euclidean distances.
+	dist := make(numericalgo.Matrix, n)
+	for i := range dist {
+		dist[i] = make(numericalgo.Vector, n)
+		for j := range dist[i] {
+			d := sqDist(x[i], x[j])
+			if a.Linkage != Ward {
+				d = math.Sqrt(d)
+			}
+			dist[i][j] = d
+		}
+	}
+
+	size := make([]float64, n)
+	parent := make([]int, n)
+	active := make([]bool, n)
+	for i := range size {
+		size[i], parent[i], active[i] = 1, i, true
+	}
+
+	for clusters := n; clusters > a.K; clusters-- {
+		p, q, best := -1, -1, math.Inf(1)
+		for i := 0; i < n; i++ {
+			if !active[i] {
+				continue
+			}
+			for j := i + 1; j < n; j++ {
+				if active[j] && dist[i][j] < best {
+					p, q, best = i, j, dist[i][j]
+				}
+			}
+		}
+
+		// Cluster q is merged into cluster p.
+		for k := 0; k < n; k++ {
+			if !active[k] || k == p || k == q {
+				continue
+			}
+			d := a.lanceWilliams(dist[p][k], dist[q][k], dist[p][q], size[p], size[q], size[k])
+			dist[p][k], dist[k][p] = d, d
+		}
+		size[p] += size[q]
+		active[q] = false
+		parent[q] = p
+	}
+
+	root := func(i int) int {
+		for parent[i] != i {
+			i = parent[i]
+		}
+		return i
+	}
+
+	ids := make(map[int]int)
+	a.Labels = make([]int, n)
+	for i := range x {
+		r := root(i)
+		if _, ok := ids[r]; !ok {
+			ids[r] = len(ids)
+		}
+		a.Labels[i] = ids[r]
+	}
+
+	a.Centroids, a.Inertia = centroids(x, a.Labels, a.K)
+	return nil
+}
+
+// lanceWilliams returns the distance between the union of clusters p and q and cluster k.
+func (a *Agglomerative) lanceWilliams(dpk, dqk, dpq, np, nq, nk float64) float64 {
+	switch a.Linkage {
+	case Single:
+		return math.Min(dpk, dqk)
+	case Complete:
+		return math.Max(dpk, dqk)
+	case Average:
+		return (np*dpk + nq*dqk) / (np + nq)
+	}
+	return ((np+nk)*dpk + (nq+nk)*dqk - nk*dpq) / (np + nq + nk)
+}
+
+// centroids returns the means of the clusters and the sum of squared distances of the observations to them.
+func centroids(x numericalgo.Matrix, labels []int, k int) (numericalgo.Matrix, float64) {
+	c := make(numericalgo.Matrix, k)
+	counts := make([]int, k)
+	for i := range c {
+		c[i] = make(numericalgo.Vector, len(x[0]))
+	}
+	for i, row := range x {
+		counts[labels[i]]++
+		for j, val := range row {
+			c[labels[i]][j] += val
+		}
+	}
+	for i := range c {
+		for j := range c[i] {
+			c[i][j] /= float64(counts[i])
+		}
+	}
+
+	var inertia float64
+	for i, row := range x {
+		inertia += sqDist(row, c[labels[i]])
+	}
+	return c, inertia
+}
@@ -0,0 +1,78 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/cluster"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgglomerative(t *testing.T) {
+	x := numericalgo.Matrix{{0.3}, {3.3}, {3.9}, {6.4}, {9.4}, {12.6}, {18.5}}
+
+	cases := map[string]struct {
+		linkage   cluster.Linkage
+		labels    []int
+		centroids numericalgo.Matrix
+		inertia   float64
+	}{
+		"single": {
+			linkage:   cluster.Single,
+			labels:    []int{0, 0, 0, 0, 0, 0, 1},
+			centroids: numericalgo.Matrix{{5.983333333333333}, {18.5}},
+			inertia:   99.46833333333333,
+		},
+		"complete": {
+			linkage:   cluster.Complete,
+			labels:    []int{0, 0, 0, 0, 0, 1, 1},
+			centroids: numericalgo.Matrix{{4.66}, {15.55}},
+			inertia:   64.337,
+		},
+		"average": {
+			linkage:   cluster.Average,
+			labels:    []int{0, 0, 0, 0, 1, 1, 1},
+			centroids: numericalgo.Matrix{{3.475}, {13.5}},
+			inertia:   61.4675,
+		},
+		"ward": {
+			linkage:   cluster.Ward,
+			labels:    []int{0, 0, 0, 1, 1, 1, 1},
+			centroids: numericalgo.Matrix{{2.5}, {11.725}},
+			inertia:   87.8675,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			a := cluster.NewAgglomerative(2, c.linkage)
+			err := a.Fit(x)
+			assert.Nil(t, err)
+			assert.Equal(t, c.labels, a.Labels)
+			assert.True(t, a.Centroids.IsSimilar(c.centroids, 1e-12))
+			assert.InEpsilon(t, c.inertia, a.Inertia, 1e-12)
+		})
+	}
+}
+
+func TestAgglomerativeSingleCluster(t *testing.T) {
+	a := cluster.NewAgglomerative(1, cluster.Ward)
+	assert.Nil(t, a.Fit(numericalgo.Matrix{{0, 0}, {2, 0}, {0, 2}, {2, 2}}))
+	assert.Equal(t, []int{0, 0, 0, 0}, a.Labels)
+	assert.True(t, a.Centroids.IsSimilar(numericalgo.Matrix{{1, 1}}, 1e-15))
+	assert.Equal(t, 8.0, a.Inertia)
+}
+
+func TestAgglomerativeInvalid(t *testing.T) {
+	x := numericalgo.Matrix{{0}, {1}}
+
+	err := cluster.NewAgglomerative(3, cluster.Single).Fit(x)
+	assert.Equal(t, fmt.Errorf("Number of clusters must be between 1 and the number of observations"), err)
+
+	err = cluster.NewAgglomerative(1, cluster.Linkage(9)).Fit(x)
+	assert.Equal(t, fmt.Errorf("Unknown linkage"), err)
+
+	err = cluster.NewAgglomerative(1, cluster.Single).Fit(numericalgo.Matrix{{0}, {1, 2}})
+	assert.Equal(t, fmt.Errorf("All observations must have the same dimension"), err)
+}
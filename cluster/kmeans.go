@@ -0,0 +1,227 @@
+package cluster
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// KMeans partitions the rows of a data matrix into K clusters by minimizing the inertia, the sum of squared
+// distances of the observations to the centroids of their clusters. Centroids are initialized with k-means++ and
+// refined with Lloyd's algorithm, and the best of Restarts independent runs is kept.
+//
+// After fitting, Labels holds the cluster of every observation, Centroids holds the centroids as rows, and Inertia
+// holds the inertia of the clustering.
+type KMeans struct {
+	K         int
+	Restarts  int
+	MaxIter   int
+	Labels    []int
+	Centroids numericalgo.Matrix
+	Inertia   float64
+	rnd       *rand.Rand
+}
+
+// NewKMeans receives the number of clusters and the seed of the random number generator, and returns the pointer to
+// the new KMeans type with 10 restarts and at most 300 iterations per run. Two KMeans types created with the same
+// seed produce the same clusterings.
+func NewKMeans(k int, seed int64) *KMeans {
+	km := &KMeans{K: k, Restarts: 10, MaxIter: 300, rnd: rand.New(rand.NewSource(seed))}
+	return km
+}
+
+// Fit receives the data matrix whose rows are observations, clusters them and stores the labels, centroids and
+// inertia. It returns the error if something went wrong.
+func (km *KMeans) Fit(x numericalgo.Matrix) error {
+	if err := checkData(x); err != nil {
+		return err
+	}
+
+	if km.K < 1 || km.K > len(x) {
+		return fmt.Errorf("Number of clusters must be between 1 and the number of observations")
+	}
+
+	if km.Restarts < 1 || km.MaxIter < 1 {
+		return fmt.Errorf("Number of restarts and iterations must be positive")
+	}
+
+	km.Inertia = math.Inf(1)
+	for r := 0; r < km.Restarts; r++ {
+		labels, centroids, inertia := km.run(x)
+		if inertia < km.Inertia {
+			km.Labels, km.Centroids, km.Inertia = labels, centroids, inertia
+		}
+	}
+	return nil
+}
+
+// Predict receives an observation and returns the index of the nearest centroid, and the error (if there is any).
+func (km *KMeans) Predict(v numericalgo.Vector) (int, error) {
+	if km.Centroids == nil {
+		return 0, fmt.Errorf("Model has not been fitted")
+	}
+
+	if v.Dim() != km.Centroids[0].Dim() {
+		return 0, fmt.Errorf("Dimensions must match")
+	}
+
+	label, _ := nearest(v, km.Centroids)
+	return label, nil
+}
+
+func (km *KMeans) run(x numericalgo.Matrix) ([]int, numericalgo.Matrix, float64) {
+	centroids := km.initialize(x)
+	labels := make([]int, len(x))
+	for i := range labels {
+		labels[i] = -1
+	}
+
+	for iter := 0; iter < km.MaxIter; iter++ {
+		changed := false
+		for i, row := range x {
+			label, _ := nearest(row, centroids)
+			if label != labels[i] {
+				labels[i] = label
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		counts := make([]int, km.K)
+		for c := range centroids {
+			centroids[c] = make(numericalgo.Vector, len(x[0]))
+		}
+		for i, row := range x {
+			counts[labels[i]]++
+			for j, val := range row {
+				centroids[labels[i]][j] += val
+			}
+		}
+
+		for c := range centroids {
+			if counts[c] == 0 {
+				// An empty cluster takes over the observation farthest from its centroid.
+				far, farDist := 0, -1.0
+				for i, row := range x {
+					if counts[labels[i]] > 1 {
+						if d := sqDist(row, centroidOf(centroids[labels[i]], counts[labels[i]])); d > farDist {
+							far, farDist = i, d
+						}
+					}
+				}
+				for j, val := range x[far] {
+					centroids[labels[far]][j] -= val
+				}
+				counts[labels[far]]--
+				labels[far] = c
+				counts[c] = 1
+				copy(centroids[c], x[far])
+			}
+		}
+
+		for c := range centroids {
+			for j := range centroids[c] {
+				centroids[c][j] /= float64(counts[c])
+			}
+		}
+	}
+
+	var inertia float64
+	for i, row := range x {
+		labels[i], _ = nearest(row, centroids)
+		inertia += sqDist(row, centroids[labels[i]])
+	}
+	return labels, centroids, inertia
+}
+
+// initialize chooses the initial centroids with k-means++: the first one uniformly at random, and every next one
+// with probability proportional to the squared distance to the nearest centroid chosen so far.
+func (km *KMeans) initialize(x numericalgo.Matrix) numericalgo.Matrix {
+	centroids := make(numericalgo.Matrix, 0, km.K)
+	first := km.rnd.Intn(len(x))
+	centroids = append(centroids, append(numericalgo.Vector{}, x[first]...))
+
+	dist := make(numericalgo.Vector, len(x))
+	for i, row := range x {
+		dist[i] = sqDist(row, centroids[0])
+	}
+
+	for len(centroids) < km.K {
+		var total float64
+		for _, d := range dist {
+			total += d
+		}
+
+		next := 0
+		if total == 0 {
+			// All observations coincide with centroids, so any observation will do.
+			next = km.rnd.Intn(len(x))
+		} else {
+			target := km.rnd.Float64() * total
+			for i, d := range dist {
+				target -= d
+				if target < 0 {
+					next = i
+					break
+				}
+				next = i
+			}
+		}
+
+		c := append(numericalgo.Vector{}, x[next]...)
+		centroids = append(centroids, c)
+		for i, row := range x {
+			dist[i] = math.Min(dist[i], sqDist(row, c))
+		}
+	}
+	return centroids
+}
+
+func centroidOf(sum numericalgo.Vector, count int) numericalgo.Vector {
+	c := make(numericalgo.Vector, len(sum))
+	for j := range sum {
+		c[j] = sum[j] / float64(count)
+	}
+	return c
+}
+
+// nearest returns the index of the centroid nearest to v and the squared distance to it.
+func nearest(v numericalgo.Vector, centroids numericalgo.Matrix) (int, float64) {
+	best, bestDist := 0, math.Inf(1)
+	for c, centroid := range centroids {
+		if d := sqDist(v, centroid); d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best, bestDist
+}
+
+func sqDist(a, b numericalgo.Vector) float64 {
+	var d float64
+	for j := range a {
+		d += (a[j] - b[j]) * (a[j] - b[j])
+	}
+	return d
+}
+
+func checkData(x numericalgo.Matrix) error {
+	if len(x) == 0 || len(x[0]) == 0 {
+		return fmt.Errorf("Data cannot be empty")
+	}
+
+	for i := range x {
+		if len(x[i]) != len(x[0]) {
+			return fmt.Errorf("All observations must have the same dimension")
+		}
+		for _, val := range x[i] {
+			if math.IsNaN(val) || math.IsInf(val, 0) {
+				return fmt.Errorf("Data must be finite")
+			}
+		}
+	}
+	return nil
+}
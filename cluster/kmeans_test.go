@@ -0,0 +1,111 @@
+package cluster_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/cluster"
+	"github.com/stretchr/testify/assert"
+)
+
+// blobs returns n observations around each of the centers, in the order of the centers.
+func blobs(centers numericalgo.Matrix, n int, spread float64, seed int64) numericalgo.Matrix {
+	r := rand.New(rand.NewSource(seed))
+	var x numericalgo.Matrix
+	for _, c := range centers {
+		for i := 0; i < n; i++ {
+			row := make(numericalgo.Vector, len(c))
+			for j := range c {
+				row[j] = c[j] + spread*r.NormFloat64()
+			}
+			x = append(x, row)
+		}
+	}
+	return x
+}
+
+func TestKMeans(t *testing.T) {
+	centers := numericalgo.Matrix{{0, 0}, {10, 0}, {0, 10}}
+	x := blobs(centers, 50, 1, 1)
+
+	km := cluster.NewKMeans(3, 42)
+	err := km.Fit(x)
+	assert.Nil(t, err)
+
+	// Every blob ends up in its own cluster.
+	for b := range centers {
+		label := km.Labels[b*50]
+		for i := b * 50; i < (b+1)*50; i++ {
+			assert.Equal(t, label, km.Labels[i])
+		}
+		assert.True(t, km.Centroids[label].IsSimilar(centers[b], 0.5))
+
+		predicted, err := km.Predict(centers[b])
+		assert.Nil(t, err)
+		assert.Equal(t, label, predicted)
+	}
+
+	// The inertia is the sum of squared distances to the assigned centroids.
+	var inertia float64
+	for i, row := range x {
+		for j := range row {
+			d := row[j] - km.Centroids[km.Labels[i]][j]
+			inertia += d * d
+		}
+	}
+	assert.InEpsilon(t, inertia, km.Inertia, 1e-12)
+}
+
+func TestKMeansReproducible(t *testing.T) {
+	x := blobs(numericalgo.Matrix{{0}, {3}, {6}, {9}}, 20, 1.5, 2)
+
+	a := cluster.NewKMeans(4, 7)
+	b := cluster.NewKMeans(4, 7)
+	assert.Nil(t, a.Fit(x))
+	assert.Nil(t, b.Fit(x))
+
+	assert.Equal(t, a.Labels, b.Labels)
+	assert.Equal(t, a.Centroids, b.Centroids)
+	assert.Equal(t, a.Inertia, b.Inertia)
+}
+
+func TestKMeansRestarts(t *testing.T) {
+	x := blobs(numericalgo.Matrix{{0, 0}, {4, 0}, {0, 4}, {4, 4}, {8, 8}}, 10, 0.8, 3)
+
+	single := cluster.NewKMeans(5, 1)
+	single.Restarts = 1
+	assert.Nil(t, single.Fit(x))
+
+	many := cluster.NewKMeans(5, 1)
+	many.Restarts = 20
+	assert.Nil(t, many.Fit(x))
+
+	assert.True(t, many.Inertia <= single.Inertia)
+}
+
+func TestKMeansDuplicates(t *testing.T) {
+	// With fewer distinct observations than clusters some clusters stay empty during the iterations.
+	x := numericalgo.Matrix{{1}, {1}, {1}, {5}, {5}}
+
+	km := cluster.NewKMeans(3, 1)
+	assert.Nil(t, km.Fit(x))
+	assert.Equal(t, 0.0, km.Inertia)
+}
+
+func TestKMeansInvalid(t *testing.T) {
+	x := numericalgo.Matrix{{0}, {1}}
+
+	km := cluster.NewKMeans(3, 1)
+	assert.Equal(t, fmt.Errorf("Number of clusters must be between 1 and the number of observations"), km.Fit(x))
+
+	_, err := km.Predict(numericalgo.Vector{0})
+	assert.Equal(t, fmt.Errorf("Model has not been fitted"), err)
+
+	km = cluster.NewKMeans(1, 1)
+	km.Restarts = 0
+	assert.Equal(t, fmt.Errorf("Number of restarts and iterations must be positive"), km.Fit(x))
+
+	assert.Equal(t, fmt.Errorf("Data cannot be empty"), cluster.NewKMeans(1, 1).Fit(numericalgo.Matrix{}))
+}
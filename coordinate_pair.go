@@ -1,6 +1,7 @@
 package numericalgo
 
 import (
+	"fmt"
 	"sort"
 )
 
@@ -17,11 +18,24 @@ func SortCoordinatePairs(cp []CoordinatePair) {
 	})
 }
 
-// SlicesToCoordinatePairs is a function which receives two slices of floats (x and y), turns them into a slice of CoordinatePairs, and returns the result.
+// SlicesToCoordinatePairs is a function which receives two slices of floats (x and y), and turns them into a slice of CoordinatePairs. If the slices differ in length, the extra elements of the longer one are ignored; use SlicesToCoordinatePairsChecked to reject such slices instead.
 func SlicesToCoordinatePairs(x, y []float64) []CoordinatePair {
-	cp := make([]CoordinatePair, len(x))
-	for i := 0; i < len(x); i++ {
+	n := len(x)
+	if len(y) < n {
+		n = len(y)
+	}
+
+	cp := make([]CoordinatePair, 0, n)
+	for i := 0; i < n; i++ {
 		cp = append(cp, CoordinatePair{X: x[i], Y: y[i]})
 	}
 	return cp
 }
+
+// SlicesToCoordinatePairsChecked is a function which receives two slices of floats (x and y), turns them into a slice of CoordinatePairs, and returns the result and the error if the X and Y sizes do not match.
+func SlicesToCoordinatePairsChecked(x, y []float64) ([]CoordinatePair, error) {
+	if len(x) != len(y) {
+		return nil, fmt.Errorf("X and Y sizes do not match")
+	}
+	return SlicesToCoordinatePairs(x, y), nil
+}
@@ -0,0 +1,86 @@
+package numericalgo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlicesToCoordinatePairsChecked(t *testing.T) {
+	cases := map[string]struct {
+		x              []float64
+		y              []float64
+		expectedResult []numericalgo.CoordinatePair
+		expectedError  error
+	}{
+		"basic test": {
+			x:              []float64{3, 1, 2},
+			y:              []float64{30, 10, 20},
+			expectedResult: []numericalgo.CoordinatePair{{X: 3, Y: 30}, {X: 1, Y: 10}, {X: 2, Y: 20}},
+			expectedError:  nil,
+		},
+		"empty slices": {
+			x:              []float64{},
+			y:              []float64{},
+			expectedResult: []numericalgo.CoordinatePair{},
+			expectedError:  nil,
+		},
+		"wrong x and y size": {
+			x:              []float64{1, 2, 3},
+			y:              []float64{1, 2},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("X and Y sizes do not match"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := numericalgo.SlicesToCoordinatePairsChecked(c.x, c.y)
+			assert.Equal(t, c.expectedError, err)
+			assert.Equal(t, c.expectedResult, result)
+		})
+	}
+}
+
+func TestSlicesToCoordinatePairs(t *testing.T) {
+	cases := map[string]struct {
+		x              []float64
+		y              []float64
+		expectedResult []numericalgo.CoordinatePair
+	}{
+		"basic test": {
+			x:              []float64{3, 1, 2},
+			y:              []float64{30, 10, 20},
+			expectedResult: []numericalgo.CoordinatePair{{X: 3, Y: 30}, {X: 1, Y: 10}, {X: 2, Y: 20}},
+		},
+		"longer x": {
+			x:              []float64{1, 2, 3},
+			y:              []float64{10, 20},
+			expectedResult: []numericalgo.CoordinatePair{{X: 1, Y: 10}, {X: 2, Y: 20}},
+		},
+		"longer y": {
+			x:              []float64{1},
+			y:              []float64{10, 20},
+			expectedResult: []numericalgo.CoordinatePair{{X: 1, Y: 10}},
+		},
+		"empty slices": {
+			x:              nil,
+			y:              nil,
+			expectedResult: []numericalgo.CoordinatePair{},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expectedResult, numericalgo.SlicesToCoordinatePairs(c.x, c.y))
+		})
+	}
+}
+
+func TestSortCoordinatePairs(t *testing.T) {
+	cp := []numericalgo.CoordinatePair{{X: 3, Y: 30}, {X: 1, Y: 10}, {X: 2, Y: 20}}
+	numericalgo.SortCoordinatePairs(cp)
+	assert.Equal(t, []numericalgo.CoordinatePair{{X: 1, Y: 10}, {X: 2, Y: 20}, {X: 3, Y: 30}}, cp)
+}
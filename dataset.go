@@ -0,0 +1,215 @@
+package numericalgo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Aggregation selects how Dedup combines the Y values of points which share the same X coordinate.
+type Aggregation int
+
+const (
+	// AggregateMean replaces the duplicate points with a single point holding the mean of their Y values.
+	AggregateMean Aggregation = iota
+	// AggregateFirst keeps the first of the duplicate points, in the order of the dataset.
+	AggregateFirst
+	// AggregateError rejects the dataset if any X coordinate appears more than once.
+	AggregateError
+)
+
+// Dataset is a slice of CoordinatePairs with helpers for building, cleaning and splitting (x, y) data before it is
+// passed to the interpolation and fit packages (d.X() and d.Y() can be passed straight to their Fit methods).
+type Dataset []CoordinatePair
+
+// NewDataset receives two vectors x and y of the same size, and returns the dataset of their coordinate pairs in the
+// given order and the error (if there is any).
+func NewDataset(x, y Vector) (Dataset, error) {
+	cp, err := SlicesToCoordinatePairsChecked(x, y)
+	if err != nil {
+		return nil, err
+	}
+	return Dataset(cp), nil
+}
+
+// DatasetFromColumns receives a matrix and the indices of its X and Y columns. It returns the dataset with one point
+// per matrix row and the error (if there is any).
+func DatasetFromColumns(m Matrix, xCol, yCol int) (Dataset, error) {
+	d := make(Dataset, 0, len(m))
+	for _, row := range m {
+		if xCol < 0 || yCol < 0 || xCol >= row.Dim() || yCol >= row.Dim() {
+			return nil, fmt.Errorf("Column index out of range")
+		}
+		d = append(d, CoordinatePair{X: row[xCol], Y: row[yCol]})
+	}
+	return d, nil
+}
+
+// DatasetFromMap receives a map from X to Y coordinates, and returns the dataset of its entries sorted by X.
+func DatasetFromMap(m map[float64]float64) Dataset {
+	d := make(Dataset, 0, len(m))
+	for x, y := range m {
+		d = append(d, CoordinatePair{X: x, Y: y})
+	}
+	d.Sort()
+	return d
+}
+
+// DatasetFromCSV receives a CSV reader, the indices of the X and Y columns and whether the first record is a header
+// which should be skipped. It returns the dataset with one point per record and the error (if there is any). Empty
+// fields and "NaN" are read as NaN, so they can be removed with DropNaN.
+func DatasetFromCSV(r io.Reader, xCol, yCol int, header bool) (Dataset, error) {
+	if xCol < 0 || yCol < 0 {
+		return nil, fmt.Errorf("Column index out of range")
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var d Dataset
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header && line == 1 {
+			continue
+		}
+		if xCol >= len(record) || yCol >= len(record) {
+			return nil, fmt.Errorf("Column index out of range on line %d", line)
+		}
+
+		x, err := parseField(record[xCol])
+		if err != nil {
+			return nil, fmt.Errorf("Invalid X value on line %d: %q", line, record[xCol])
+		}
+		y, err := parseField(record[yCol])
+		if err != nil {
+			return nil, fmt.Errorf("Invalid Y value on line %d: %q", line, record[yCol])
+		}
+		d = append(d, CoordinatePair{X: x, Y: y})
+	}
+	return d, nil
+}
+
+// X returns the vector of the X coordinates of the dataset.
+func (d Dataset) X() Vector {
+	x := make(Vector, len(d))
+	for i, p := range d {
+		x[i] = p.X
+	}
+	return x
+}
+
+// Y returns the vector of the Y coordinates of the dataset.
+func (d Dataset) Y() Vector {
+	y := make(Vector, len(d))
+	for i, p := range d {
+		y[i] = p.Y
+	}
+	return y
+}
+
+// Sort sorts the dataset in place in ascending order of X. Points with equal X keep their relative order.
+func (d Dataset) Sort() {
+	sort.SliceStable(d, func(i, j int) bool {
+		return d[i].X < d[j].X
+	})
+}
+
+// Dedup receives the aggregation method, and returns a new dataset sorted by X in which every X coordinate appears
+// once, and the error (if there is any). The receiver is left untouched.
+func (d Dataset) Dedup(agg Aggregation) (Dataset, error) {
+	if agg != AggregateMean && agg != AggregateFirst && agg != AggregateError {
+		return nil, fmt.Errorf("Unknown aggregation")
+	}
+
+	sorted := make(Dataset, len(d))
+	copy(sorted, d)
+	sorted.Sort()
+
+	r := make(Dataset, 0, len(sorted))
+	for i := 0; i < len(sorted); {
+		j := i + 1
+		for j < len(sorted) && sorted[j].X == sorted[i].X {
+			j++
+		}
+
+		p := sorted[i]
+		if j-i > 1 {
+			switch agg {
+			case AggregateError:
+				return nil, fmt.Errorf("Duplicate X value %v", p.X)
+			case AggregateMean:
+				group := make(Vector, 0, j-i)
+				for _, q := range sorted[i:j] {
+					group = append(group, q.Y)
+				}
+				p.Y = group.Sum() / float64(j-i)
+			}
+		}
+		r = append(r, p)
+		i = j
+	}
+	return r, nil
+}
+
+// DropNaN returns a new dataset without the points whose X or Y coordinate is NaN.
+func (d Dataset) DropNaN() Dataset {
+	r := make(Dataset, 0, len(d))
+	for _, p := range d {
+		if !math.IsNaN(p.X) && !math.IsNaN(p.Y) {
+			r = append(r, p)
+		}
+	}
+	return r
+}
+
+// Split receives the fraction in [0, 1] of the points which should go into the first part. It returns the first
+// round(fraction*n) points and the remaining points, both in the order of the dataset, and the error (if there is
+// any). Sort the dataset or shuffle it beforehand, depending on whether an ordered or a random split is needed.
+func (d Dataset) Split(fraction float64) (Dataset, Dataset, error) {
+	if fraction < 0 || fraction > 1 || math.IsNaN(fraction) {
+		return nil, nil, fmt.Errorf("Fraction must be between 0 and 1")
+	}
+
+	k := int(math.Round(fraction * float64(len(d))))
+	first := make(Dataset, k)
+	copy(first, d[:k])
+	second := make(Dataset, len(d)-k)
+	copy(second, d[k:])
+	return first, second, nil
+}
+
+// Windows receives the window size and the step between the starts of consecutive windows. It returns all the
+// complete windows of the dataset (they overlap if step is smaller than size), and the error (if there is any).
+func (d Dataset) Windows(size, step int) ([]Dataset, error) {
+	if size <= 0 || step <= 0 {
+		return nil, fmt.Errorf("Window size and step must be positive")
+	}
+
+	var r []Dataset
+	for start := 0; start+size <= len(d); start += step {
+		w := make(Dataset, size)
+		copy(w, d[start:start+size])
+		r = append(r, w)
+	}
+	return r, nil
+}
+
+// parseField parses a CSV field as a float, reading an empty field as NaN.
+func parseField(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return math.NaN(), nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
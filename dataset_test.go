@@ -0,0 +1,298 @@
+package numericalgo_test
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/fit/linear"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDataset(t *testing.T) {
+	cases := map[string]struct {
+		x              numericalgo.Vector
+		y              numericalgo.Vector
+		expectedResult numericalgo.Dataset
+		expectedError  error
+	}{
+		"basic test": {
+			x:              numericalgo.Vector{2, 1},
+			y:              numericalgo.Vector{4, 1},
+			expectedResult: numericalgo.Dataset{{X: 2, Y: 4}, {X: 1, Y: 1}},
+			expectedError:  nil,
+		},
+		"wrong x and y size": {
+			x:              numericalgo.Vector{1, 2},
+			y:              numericalgo.Vector{1},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("X and Y sizes do not match"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := numericalgo.NewDataset(c.x, c.y)
+			assert.Equal(t, c.expectedError, err)
+			assert.Equal(t, c.expectedResult, result)
+		})
+	}
+}
+
+func TestDatasetFromColumns(t *testing.T) {
+	m := numericalgo.Matrix{
+		{1, 10, 100},
+		{2, 20, 200},
+	}
+
+	cases := map[string]struct {
+		xCol           int
+		yCol           int
+		expectedResult numericalgo.Dataset
+		expectedError  error
+	}{
+		"first and last column": {
+			xCol:           0,
+			yCol:           2,
+			expectedResult: numericalgo.Dataset{{X: 1, Y: 100}, {X: 2, Y: 200}},
+			expectedError:  nil,
+		},
+		"swapped columns": {
+			xCol:           1,
+			yCol:           0,
+			expectedResult: numericalgo.Dataset{{X: 10, Y: 1}, {X: 20, Y: 2}},
+			expectedError:  nil,
+		},
+		"column out of range": {
+			xCol:           0,
+			yCol:           3,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Column index out of range"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := numericalgo.DatasetFromColumns(m, c.xCol, c.yCol)
+			assert.Equal(t, c.expectedError, err)
+			assert.Equal(t, c.expectedResult, result)
+		})
+	}
+}
+
+func TestDatasetFromMap(t *testing.T) {
+	d := numericalgo.DatasetFromMap(map[float64]float64{3: 9, 1: 1, 2: 4})
+	assert.Equal(t, numericalgo.Dataset{{X: 1, Y: 1}, {X: 2, Y: 4}, {X: 3, Y: 9}}, d)
+}
+
+func TestDatasetFromCSV(t *testing.T) {
+	cases := map[string]struct {
+		input          string
+		xCol           int
+		yCol           int
+		header         bool
+		expectedResult numericalgo.Dataset
+		expectedError  error
+	}{
+		"with header": {
+			input:          "t,label,value\n0.5,a,1.25\n1.5,b,-2\n",
+			xCol:           0,
+			yCol:           2,
+			header:         true,
+			expectedResult: numericalgo.Dataset{{X: 0.5, Y: 1.25}, {X: 1.5, Y: -2}},
+			expectedError:  nil,
+		},
+		"without header": {
+			input:          "1, 2\n3, 4\n",
+			xCol:           0,
+			yCol:           1,
+			header:         false,
+			expectedResult: numericalgo.Dataset{{X: 1, Y: 2}, {X: 3, Y: 4}},
+			expectedError:  nil,
+		},
+		"invalid value": {
+			input:          "x,y\n1,2\n3,abc\n",
+			xCol:           0,
+			yCol:           1,
+			header:         true,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Invalid Y value on line 3: \"abc\""),
+		},
+		"short record": {
+			input:          "1,2\n3\n",
+			xCol:           0,
+			yCol:           1,
+			header:         false,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Column index out of range on line 2"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := numericalgo.DatasetFromCSV(strings.NewReader(c.input), c.xCol, c.yCol, c.header)
+			assert.Equal(t, c.expectedError, err)
+			assert.Equal(t, c.expectedResult, result)
+		})
+	}
+}
+
+func TestDatasetFromCSVMissingValues(t *testing.T) {
+	d, err := numericalgo.DatasetFromCSV(strings.NewReader("1,2\n2,\n3,NaN\n4,8\n"), 0, 1, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(d))
+	assert.True(t, math.IsNaN(d[1].Y))
+	assert.Equal(t, numericalgo.Dataset{{X: 1, Y: 2}, {X: 4, Y: 8}}, d.DropNaN())
+}
+
+func TestDatasetXY(t *testing.T) {
+	d := numericalgo.Dataset{{X: 1, Y: 10}, {X: 2, Y: 20}}
+	assert.Equal(t, numericalgo.Vector{1, 2}, d.X())
+	assert.Equal(t, numericalgo.Vector{10, 20}, d.Y())
+}
+
+func TestDatasetSort(t *testing.T) {
+	d := numericalgo.Dataset{{X: 2, Y: 1}, {X: 1, Y: 2}, {X: 2, Y: 3}, {X: 0, Y: 4}}
+	d.Sort()
+	assert.Equal(t, numericalgo.Dataset{{X: 0, Y: 4}, {X: 1, Y: 2}, {X: 2, Y: 1}, {X: 2, Y: 3}}, d)
+}
+
+func TestDatasetDedup(t *testing.T) {
+	d := numericalgo.Dataset{{X: 2, Y: 1}, {X: 1, Y: 5}, {X: 2, Y: 3}, {X: 2, Y: 8}}
+
+	cases := map[string]struct {
+		agg            numericalgo.Aggregation
+		expectedResult numericalgo.Dataset
+		expectedError  error
+	}{
+		"mean": {
+			agg:            numericalgo.AggregateMean,
+			expectedResult: numericalgo.Dataset{{X: 1, Y: 5}, {X: 2, Y: 4}},
+			expectedError:  nil,
+		},
+		"first": {
+			agg:            numericalgo.AggregateFirst,
+			expectedResult: numericalgo.Dataset{{X: 1, Y: 5}, {X: 2, Y: 1}},
+			expectedError:  nil,
+		},
+		"error": {
+			agg:            numericalgo.AggregateError,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Duplicate X value 2"),
+		},
+		"unknown aggregation": {
+			agg:            numericalgo.Aggregation(42),
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Unknown aggregation"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := d.Dedup(c.agg)
+			assert.Equal(t, c.expectedError, err)
+			assert.Equal(t, c.expectedResult, result)
+		})
+	}
+
+	assert.Equal(t, numericalgo.Dataset{{X: 2, Y: 1}, {X: 1, Y: 5}, {X: 2, Y: 3}, {X: 2, Y: 8}}, d)
+
+	unique, err := numericalgo.Dataset{{X: 3, Y: 1}, {X: 1, Y: 2}}.Dedup(numericalgo.AggregateError)
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Dataset{{X: 1, Y: 2}, {X: 3, Y: 1}}, unique)
+}
+
+func TestDatasetSplit(t *testing.T) {
+	d := numericalgo.Dataset{{X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}, {X: 4, Y: 4}, {X: 5, Y: 5}}
+
+	cases := map[string]struct {
+		fraction       float64
+		expectedFirst  numericalgo.Dataset
+		expectedSecond numericalgo.Dataset
+		expectedError  error
+	}{
+		"eighty percent": {
+			fraction:       0.8,
+			expectedFirst:  numericalgo.Dataset{{X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}, {X: 4, Y: 4}},
+			expectedSecond: numericalgo.Dataset{{X: 5, Y: 5}},
+			expectedError:  nil,
+		},
+		"everything": {
+			fraction:       1,
+			expectedFirst:  d,
+			expectedSecond: numericalgo.Dataset{},
+			expectedError:  nil,
+		},
+		"invalid fraction": {
+			fraction:       1.5,
+			expectedFirst:  nil,
+			expectedSecond: nil,
+			expectedError:  fmt.Errorf("Fraction must be between 0 and 1"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			first, second, err := d.Split(c.fraction)
+			assert.Equal(t, c.expectedError, err)
+			assert.Equal(t, c.expectedFirst, first)
+			assert.Equal(t, c.expectedSecond, second)
+		})
+	}
+}
+
+func TestDatasetWindows(t *testing.T) {
+	d := numericalgo.Dataset{{X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}, {X: 4, Y: 4}, {X: 5, Y: 5}}
+
+	cases := map[string]struct {
+		size           int
+		step           int
+		expectedResult []numericalgo.Dataset
+		expectedError  error
+	}{
+		"overlapping windows": {
+			size: 3,
+			step: 1,
+			expectedResult: []numericalgo.Dataset{
+				{{X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}},
+				{{X: 2, Y: 2}, {X: 3, Y: 3}, {X: 4, Y: 4}},
+				{{X: 3, Y: 3}, {X: 4, Y: 4}, {X: 5, Y: 5}},
+			},
+			expectedError: nil,
+		},
+		"disjoint windows drop the incomplete tail": {
+			size: 2,
+			step: 2,
+			expectedResult: []numericalgo.Dataset{
+				{{X: 1, Y: 1}, {X: 2, Y: 2}},
+				{{X: 3, Y: 3}, {X: 4, Y: 4}},
+			},
+			expectedError: nil,
+		},
+		"invalid step": {
+			size:           2,
+			step:           0,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Window size and step must be positive"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := d.Windows(c.size, c.step)
+			assert.Equal(t, c.expectedError, err)
+			assert.Equal(t, c.expectedResult, result)
+		})
+	}
+}
+
+func TestDatasetFit(t *testing.T) {
+	d := numericalgo.DatasetFromMap(map[float64]float64{0: 1, 1: 3, 2: 5, 3: 7})
+
+	lf := linear.New()
+	err := lf.Fit(d.X(), d.Y())
+	assert.Nil(t, err)
+	assert.InEpsilon(t, 9, lf.Predict(4), 1e-9)
+}
@@ -0,0 +1,53 @@
+package differentiate
+
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// complexStepSize is the imaginary step used by the complex-step derivatives. No difference of function values is
+// taken, so the step can be far below the square root of the machine epsilon without any cancellation.
+const complexStepSize = 1e-20
+
+// ComplexStep receives an analytic function extended to complex arguments and the value. It returns the first
+// derivative of the function at the value, computed as Im(f(x + ih)) / h with a tiny h. The result is accurate to
+// machine precision because, unlike finite differences, no function values are subtracted. The function must be
+// written with complex arithmetic throughout (math/cmplx instead of math, and no abs or comparisons on the argument).
+func ComplexStep(f func(complex128) complex128, x float64) float64 {
+	return imag(f(complex(x, complexStepSize))) / complexStepSize
+}
+
+// ComplexStepJacobian receives an analytic vector function extended to complex arguments and the point x. It returns
+// the Jacobian matrix J[i][j] = df_i/dx_j of the function at x, with every column computed from one complex-step
+// evaluation, and the error (if there is any).
+func ComplexStepJacobian(f func([]complex128) []complex128, x numericalgo.Vector) (numericalgo.Matrix, error) {
+	n := x.Dim()
+	if n == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+
+	var j numericalgo.Matrix
+	z := make([]complex128, n)
+	for col := 0; col < n; col++ {
+		for i := range z {
+			z[i] = complex(x[i], 0)
+		}
+		z[col] = complex(x[col], complexStepSize)
+
+		fz := f(z)
+		if j == nil {
+			j = make(numericalgo.Matrix, len(fz))
+			for row := range j {
+				j[row] = make(numericalgo.Vector, n)
+			}
+		}
+		if len(fz) != len(j) {
+			return nil, fmt.Errorf("Function output sizes do not match")
+		}
+		for row := range fz {
+			j[row][col] = imag(fz[row]) / complexStepSize
+		}
+	}
+	return j, nil
+}
@@ -0,0 +1,87 @@
+package differentiate_test
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/differentiate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComplexStep(t *testing.T) {
+	cases := map[string]struct {
+		f             func(complex128) complex128
+		val           float64
+		expectedValue float64
+	}{
+		"cosine of a polynomial": {
+			f: func(z complex128) complex128 {
+				return cmplx.Cos(z*z - 2)
+			},
+			val:           1,
+			expectedValue: 2 * math.Sin(1),
+		},
+		"exponential over a root": {
+			// The classic test function of Squire and Trapp.
+			f: func(z complex128) complex128 {
+				return cmplx.Exp(z) / cmplx.Sqrt(cmplx.Pow(cmplx.Sin(z), 3)+cmplx.Pow(cmplx.Cos(z), 3))
+			},
+			val:           1.5,
+			expectedValue: 4.053427893898621,
+		},
+		"tiny derivative of a large value": {
+			f: func(z complex128) complex128 {
+				return 1e10 + 1e-10*z*z
+			},
+			val:           3,
+			expectedValue: 6e-10,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result := differentiate.ComplexStep(c.f, c.val)
+			assert.InEpsilon(t, c.expectedValue, result, 1e-14)
+		})
+	}
+}
+
+func TestComplexStepJacobian(t *testing.T) {
+	// f(x, y, z) = (x*y, sin(z) + x, exp(y*z))
+	f := func(v []complex128) []complex128 {
+		return []complex128{v[0] * v[1], cmplx.Sin(v[2]) + v[0], cmplx.Exp(v[1] * v[2])}
+	}
+	x := numericalgo.Vector{2, 0.5, -1}
+	e := math.Exp(-0.5)
+	expected := numericalgo.Matrix{
+		{0.5, 2, 0},
+		{1, 0, math.Cos(-1)},
+		{0, -e, 0.5 * e},
+	}
+
+	result, err := differentiate.ComplexStepJacobian(f, x)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(result))
+	for i := range expected {
+		assert.InDeltaSlice(t, expected[i], result[i], 1e-15)
+	}
+}
+
+func TestComplexStepJacobianErrors(t *testing.T) {
+	calls := 0
+	f := func(v []complex128) []complex128 {
+		calls++
+		if calls > 1 {
+			return v[:1]
+		}
+		return v
+	}
+	_, err := differentiate.ComplexStepJacobian(f, numericalgo.Vector{1, 2})
+	assert.Equal(t, fmt.Errorf("Function output sizes do not match"), err)
+
+	_, err = differentiate.ComplexStepJacobian(f, numericalgo.Vector{})
+	assert.Equal(t, fmt.Errorf("Vector cannot be empty"), err)
+}
@@ -0,0 +1,187 @@
+package differentiate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Scheme selects the finite difference formula used by the multivariate derivatives.
+type Scheme int
+
+const (
+	// CentralDifference uses symmetric differences, which are second-order accurate and need twice as many function
+	// evaluations as forward differences.
+	CentralDifference Scheme = iota
+	// ForwardDifference uses one-sided differences, which are first-order accurate and reuse f(x) for every
+	// coordinate.
+	ForwardDifference
+)
+
+// Gradient receives a scalar function of a vector and the point x. It returns the gradient of the function at x
+// computed with central differences, and the error (if there is any).
+func Gradient(f func(numericalgo.Vector) float64, x numericalgo.Vector) (numericalgo.Vector, error) {
+	return GradientWith(f, x, CentralDifference)
+}
+
+// GradientWith receives a scalar function of a vector, the point x and the difference scheme. It returns the gradient
+// of the function at x and the error (if there is any). The step for every coordinate is scaled by max(1, |x_i|), so
+// coordinates of very different magnitudes are all differentiated with a relative step close to the optimal one.
+func GradientWith(f func(numericalgo.Vector) float64, x numericalgo.Vector, scheme Scheme) (numericalgo.Vector, error) {
+	if x.Dim() == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+	if scheme != CentralDifference && scheme != ForwardDifference {
+		return nil, fmt.Errorf("Unknown difference scheme")
+	}
+
+	var fx float64
+	if scheme == ForwardDifference {
+		fx = f(copyVector(x))
+	}
+
+	g := make(numericalgo.Vector, x.Dim())
+	for i := range x {
+		h := step(x[i], scheme, 1)
+		if scheme == ForwardDifference {
+			g[i] = (f(shifted(x, i, h)) - fx) / h
+		} else {
+			g[i] = (f(shifted(x, i, h)) - f(shifted(x, i, -h))) / (2 * h)
+		}
+	}
+	return g, nil
+}
+
+// Jacobian receives a vector function of a vector and the point x. It returns the Jacobian matrix J[i][j] = df_i/dx_j
+// of the function at x computed with central differences, and the error (if there is any).
+func Jacobian(f func(numericalgo.Vector) numericalgo.Vector, x numericalgo.Vector) (numericalgo.Matrix, error) {
+	return JacobianWith(f, x, CentralDifference)
+}
+
+// JacobianWith receives a vector function of a vector, the point x and the difference scheme. It returns the Jacobian
+// matrix J[i][j] = df_i/dx_j of the function at x, and the error (if there is any). Every column costs one function
+// evaluation with forward differences and two with central differences.
+func JacobianWith(f func(numericalgo.Vector) numericalgo.Vector, x numericalgo.Vector, scheme Scheme) (numericalgo.Matrix, error) {
+	if x.Dim() == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+	if scheme != CentralDifference && scheme != ForwardDifference {
+		return nil, fmt.Errorf("Unknown difference scheme")
+	}
+
+	fx := f(copyVector(x))
+	m := fx.Dim()
+
+	j := make(numericalgo.Matrix, m)
+	for i := range j {
+		j[i] = make(numericalgo.Vector, x.Dim())
+	}
+
+	for col := range x {
+		h := step(x[col], scheme, 1)
+		hi := f(shifted(x, col, h))
+		lo := fx
+		div := h
+		if scheme == CentralDifference {
+			lo = f(shifted(x, col, -h))
+			div = 2 * h
+		}
+		if hi.Dim() != m || lo.Dim() != m {
+			return nil, fmt.Errorf("Function output sizes do not match")
+		}
+		for row := 0; row < m; row++ {
+			j[row][col] = (hi[row] - lo[row]) / div
+		}
+	}
+	return j, nil
+}
+
+// Hessian receives a scalar function of a vector and the point x. It returns the Hessian matrix of second partial
+// derivatives of the function at x computed with central differences, and the error (if there is any).
+func Hessian(f func(numericalgo.Vector) float64, x numericalgo.Vector) (numericalgo.Matrix, error) {
+	return HessianWith(f, x, CentralDifference)
+}
+
+// HessianWith receives a scalar function of a vector, the point x and the difference scheme. It returns the symmetric
+// Hessian matrix of second partial derivatives of the function at x, and the error (if there is any). The central
+// scheme needs 2n^2 + 1 function evaluations and the forward scheme (n+1)(n+2)/2.
+func HessianWith(f func(numericalgo.Vector) float64, x numericalgo.Vector, scheme Scheme) (numericalgo.Matrix, error) {
+	n := x.Dim()
+	if n == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+	if scheme != CentralDifference && scheme != ForwardDifference {
+		return nil, fmt.Errorf("Unknown difference scheme")
+	}
+
+	hs := make(numericalgo.Vector, n)
+	for i := range x {
+		hs[i] = step(x[i], scheme, 2)
+	}
+
+	hess := make(numericalgo.Matrix, n)
+	for i := range hess {
+		hess[i] = make(numericalgo.Vector, n)
+	}
+
+	fx := f(copyVector(x))
+	if scheme == ForwardDifference {
+		fi := make(numericalgo.Vector, n)
+		for i := range x {
+			fi[i] = f(shifted(x, i, hs[i]))
+		}
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				fij := f(shifted(shifted(x, i, hs[i]), j, hs[j]))
+				hess[i][j] = (fij - fi[i] - fi[j] + fx) / (hs[i] * hs[j])
+				hess[j][i] = hess[i][j]
+			}
+		}
+		return hess, nil
+	}
+
+	for i := 0; i < n; i++ {
+		hi := hs[i]
+		hess[i][i] = (f(shifted(x, i, hi)) - 2*fx + f(shifted(x, i, -hi))) / (hi * hi)
+		for j := i + 1; j < n; j++ {
+			hj := hs[j]
+			pp := f(shifted(shifted(x, i, hi), j, hj))
+			pm := f(shifted(shifted(x, i, hi), j, -hj))
+			mp := f(shifted(shifted(x, i, -hi), j, hj))
+			mm := f(shifted(shifted(x, i, -hi), j, -hj))
+			hess[i][j] = (pp - pm - mp + mm) / (4 * hi * hj)
+			hess[j][i] = hess[i][j]
+		}
+	}
+	return hess, nil
+}
+
+// step returns the finite difference step for the coordinate xi and the derivative order, balancing the truncation
+// error of the scheme against the rounding error of the function values: eps^(1/(order+1)) for forward and
+// eps^(1/(order+2)) for central differences, scaled by max(1, |xi|). The step is rounded so that xi + h is exactly
+// representable.
+func step(xi float64, scheme Scheme, order int) float64 {
+	eps := math.Nextafter(1, 2) - 1
+	power := float64(order + 1)
+	if scheme == CentralDifference {
+		power++
+	}
+
+	h := math.Pow(eps, 1/power) * math.Max(1, math.Abs(xi))
+	return (xi + h) - xi
+}
+
+// shifted returns a copy of x with h added to its i-th coordinate.
+func shifted(x numericalgo.Vector, i int, h float64) numericalgo.Vector {
+	r := copyVector(x)
+	r[i] += h
+	return r
+}
+
+// copyVector returns a copy of x, so that the function being differentiated cannot modify the point.
+func copyVector(x numericalgo.Vector) numericalgo.Vector {
+	r := make(numericalgo.Vector, x.Dim())
+	copy(r, x)
+	return r
+}
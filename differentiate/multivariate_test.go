@@ -0,0 +1,223 @@
+package differentiate_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/differentiate"
+	"github.com/stretchr/testify/assert"
+)
+
+// rosenbrock is f(x, y) = (1-x)^2 + 100(y-x^2)^2.
+func rosenbrock(v numericalgo.Vector) float64 {
+	return math.Pow(1-v[0], 2) + 100*math.Pow(v[1]-v[0]*v[0], 2)
+}
+
+func rosenbrockGradient(v numericalgo.Vector) numericalgo.Vector {
+	x, y := v[0], v[1]
+	return numericalgo.Vector{-2*(1-x) - 400*x*(y-x*x), 200 * (y - x*x)}
+}
+
+func rosenbrockHessian(v numericalgo.Vector) numericalgo.Matrix {
+	x, y := v[0], v[1]
+	return numericalgo.Matrix{
+		{2 - 400*y + 1200*x*x, -400 * x},
+		{-400 * x, 200},
+	}
+}
+
+func TestGradient(t *testing.T) {
+	cases := map[string]struct {
+		x      numericalgo.Vector
+		scheme differentiate.Scheme
+		tol    float64
+	}{
+		"central at the origin": {
+			x:      numericalgo.Vector{0, 0},
+			scheme: differentiate.CentralDifference,
+			tol:    1e-8,
+		},
+		"central away from the minimum": {
+			x:      numericalgo.Vector{-1.2, 1},
+			scheme: differentiate.CentralDifference,
+			tol:    1e-8,
+		},
+		"forward away from the minimum": {
+			x:      numericalgo.Vector{-1.2, 1},
+			scheme: differentiate.ForwardDifference,
+			tol:    1e-5,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := differentiate.GradientWith(rosenbrock, c.x, c.scheme)
+			assert.Nil(t, err)
+			expected := rosenbrockGradient(c.x)
+			scale, _ := expected.Norm(2)
+			for i := range expected {
+				assert.InDelta(t, expected[i], result[i], c.tol*math.Max(1, scale))
+			}
+		})
+	}
+}
+
+func TestGradientScaledSteps(t *testing.T) {
+	// An absolute step would be lost in the rounding of the first coordinate.
+	f := func(v numericalgo.Vector) float64 {
+		return math.Sqrt(v[0])/1e4 + math.Exp(v[1])
+	}
+	x := numericalgo.Vector{1e8, 1e-3}
+
+	for _, scheme := range []differentiate.Scheme{differentiate.CentralDifference, differentiate.ForwardDifference} {
+		g, err := differentiate.GradientWith(f, x, scheme)
+		assert.Nil(t, err)
+		assert.InEpsilon(t, 0.5e-8, g[0], 1e-6)
+		assert.InEpsilon(t, math.Exp(1e-3), g[1], 1e-6)
+	}
+}
+
+func TestGradientErrors(t *testing.T) {
+	_, err := differentiate.Gradient(rosenbrock, numericalgo.Vector{})
+	assert.Equal(t, fmt.Errorf("Vector cannot be empty"), err)
+
+	_, err = differentiate.GradientWith(rosenbrock, numericalgo.Vector{1, 1}, differentiate.Scheme(7))
+	assert.Equal(t, fmt.Errorf("Unknown difference scheme"), err)
+}
+
+func TestGradientDoesNotModifyPoint(t *testing.T) {
+	x := numericalgo.Vector{1, 2}
+	f := func(v numericalgo.Vector) float64 {
+		s := v[0] + v[1]
+		v[0] = 100
+		return s
+	}
+	g, err := differentiate.Gradient(f, x)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, numericalgo.Vector{1, 1}, g, 1e-9)
+	assert.Equal(t, numericalgo.Vector{1, 2}, x)
+}
+
+func TestJacobian(t *testing.T) {
+	// f(x, y, z) = (x*y, sin(z) + x, exp(y*z))
+	f := func(v numericalgo.Vector) numericalgo.Vector {
+		return numericalgo.Vector{v[0] * v[1], math.Sin(v[2]) + v[0], math.Exp(v[1] * v[2])}
+	}
+	x := numericalgo.Vector{2, 0.5, -1}
+	e := math.Exp(-0.5)
+	expected := numericalgo.Matrix{
+		{0.5, 2, 0},
+		{1, 0, math.Cos(-1)},
+		{0, -e, 0.5 * e},
+	}
+
+	cases := map[string]struct {
+		scheme differentiate.Scheme
+		tol    float64
+	}{
+		"central": {
+			scheme: differentiate.CentralDifference,
+			tol:    1e-9,
+		},
+		"forward": {
+			scheme: differentiate.ForwardDifference,
+			tol:    1e-7,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := differentiate.JacobianWith(f, x, c.scheme)
+			assert.Nil(t, err)
+			assert.Equal(t, 3, len(result))
+			for i := range expected {
+				assert.InDeltaSlice(t, expected[i], result[i], c.tol)
+			}
+		})
+	}
+}
+
+func TestJacobianNonSquare(t *testing.T) {
+	f := func(v numericalgo.Vector) numericalgo.Vector {
+		return numericalgo.Vector{v[0] * v[0]}
+	}
+	result, err := differentiate.Jacobian(f, numericalgo.Vector{3, 4})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+	assert.InDeltaSlice(t, numericalgo.Vector{6, 0}, result[0], 1e-9)
+}
+
+func TestJacobianErrors(t *testing.T) {
+	calls := 0
+	f := func(v numericalgo.Vector) numericalgo.Vector {
+		calls++
+		if calls > 1 {
+			return numericalgo.Vector{v[0]}
+		}
+		return numericalgo.Vector{v[0], v[1]}
+	}
+	_, err := differentiate.Jacobian(f, numericalgo.Vector{1, 2})
+	assert.Equal(t, fmt.Errorf("Function output sizes do not match"), err)
+
+	_, err = differentiate.Jacobian(f, numericalgo.Vector{})
+	assert.Equal(t, fmt.Errorf("Vector cannot be empty"), err)
+}
+
+func TestHessian(t *testing.T) {
+	cases := map[string]struct {
+		x      numericalgo.Vector
+		scheme differentiate.Scheme
+		tol    float64
+	}{
+		"central": {
+			x:      numericalgo.Vector{-1.2, 1},
+			scheme: differentiate.CentralDifference,
+			tol:    1e-5,
+		},
+		"forward": {
+			x:      numericalgo.Vector{-1.2, 1},
+			scheme: differentiate.ForwardDifference,
+			tol:    1e-2,
+		},
+		"central at the minimum": {
+			x:      numericalgo.Vector{1, 1},
+			scheme: differentiate.CentralDifference,
+			tol:    1e-5,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := differentiate.HessianWith(rosenbrock, c.x, c.scheme)
+			assert.Nil(t, err)
+			expected := rosenbrockHessian(c.x)
+			for i := range expected {
+				for j := range expected[i] {
+					assert.InEpsilon(t, expected[i][j], result[i][j], c.tol)
+				}
+			}
+			assert.Equal(t, result[0][1], result[1][0])
+		})
+	}
+}
+
+func TestHessianThreeDimensions(t *testing.T) {
+	// f(x, y, z) = x^2 y + y z^3
+	f := func(v numericalgo.Vector) float64 {
+		return v[0]*v[0]*v[1] + v[1]*math.Pow(v[2], 3)
+	}
+	x := numericalgo.Vector{1, 2, 3}
+	expected := numericalgo.Matrix{
+		{4, 2, 0},
+		{2, 0, 27},
+		{0, 27, 36},
+	}
+
+	result, err := differentiate.Hessian(f, x)
+	assert.Nil(t, err)
+	for i := range expected {
+		assert.InDeltaSlice(t, expected[i], result[i], 1e-5)
+	}
+}
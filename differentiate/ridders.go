@@ -0,0 +1,66 @@
+package differentiate
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// riddersShrink is the factor by which the step size is divided between consecutive central differences.
+	riddersShrink = 1.4
+	// riddersTableSize is the maximum number of step sizes tried.
+	riddersTableSize = 10
+	// riddersSafe stops the extrapolation once the error of the highest order grows this many times past the best one.
+	riddersSafe = 2.0
+)
+
+// Ridders receives the function, the value and the initial step size h. It returns the first derivative of the
+// function at the value, the estimate of its absolute error, and the error (if there is any). Starting from h,
+// central differences are taken with steps shrinking by a factor of 1.4 and extrapolated to zero step size with
+// Richardson's method (Ridders' algorithm). The initial step does not need to be small; a step over which the function
+// changes appreciably works best. The tableau stops as soon as roundoff makes the estimates worse, and the result with
+// the smallest error estimate is returned.
+func Ridders(f func(float64) float64, val, h float64) (float64, float64, error) {
+	if h <= 0 {
+		return 0, 0, fmt.Errorf("Step size has to be greater than 0")
+	}
+
+	a := make([][]float64, riddersTableSize)
+	for i := range a {
+		a[i] = make([]float64, riddersTableSize)
+	}
+
+	a[0][0] = (f(val+h) - f(val-h)) / (2 * h)
+	best, bestErr := a[0][0], math.Inf(1)
+	for i := 1; i < riddersTableSize; i++ {
+		h /= riddersShrink
+		a[0][i] = (f(val+h) - f(val-h)) / (2 * h)
+
+		fac := riddersShrink * riddersShrink
+		for j := 1; j <= i; j++ {
+			a[j][i] = (a[j-1][i]*fac - a[j-1][i-1]) / (fac - 1)
+			fac *= riddersShrink * riddersShrink
+
+			e := math.Max(math.Abs(a[j][i]-a[j-1][i]), math.Abs(a[j][i]-a[j-1][i-1]))
+			if e <= bestErr {
+				best, bestErr = a[j][i], e
+			}
+		}
+
+		if math.Abs(a[i][i]-a[i-1][i-1]) >= riddersSafe*bestErr {
+			break
+		}
+	}
+
+	if math.IsNaN(best) || math.IsInf(bestErr, 1) {
+		return 0, 0, fmt.Errorf("Derivative could not be estimated")
+	}
+	return best, bestErr, nil
+}
+
+// Adaptive receives the function and the value, and returns the first derivative of the function at the value, the
+// estimate of its absolute error, and the error (if there is any). It runs Ridders with the initial step size
+// 0.1*max(1, |val|), so the caller does not have to choose a step size at all.
+func Adaptive(f func(float64) float64, val float64) (float64, float64, error) {
+	return Ridders(f, val, 0.1*math.Max(1, math.Abs(val)))
+}
@@ -0,0 +1,119 @@
+package differentiate_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/differentiate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRidders(t *testing.T) {
+	cases := map[string]struct {
+		f             func(x float64) float64
+		val           float64
+		h             float64
+		expectedValue float64
+		expectedError error
+	}{
+		"ridders with 0.1 step size": {
+			f: func(x float64) float64 {
+				return math.Cos(math.Pow(x, 2) - 2)
+			},
+			val:           1,
+			h:             0.1,
+			expectedValue: 2 * math.Sin(1),
+			expectedError: nil,
+		},
+		"ridders with a large step size": {
+			f: func(x float64) float64 {
+				return math.Cos(math.Pow(x, 2) - 2)
+			},
+			val:           1,
+			h:             0.5,
+			expectedValue: 2 * math.Sin(1),
+			expectedError: nil,
+		},
+		"ridders on a steep function": {
+			f:             math.Exp,
+			val:           10,
+			h:             1,
+			expectedValue: math.Exp(10),
+			expectedError: nil,
+		},
+		"ridders with wrong step size": {
+			f: func(x float64) float64 {
+				return math.Cos(math.Pow(x, 2) - 2)
+			},
+			val:           1,
+			h:             -2,
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Step size has to be greater than 0"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, estimate, err := differentiate.Ridders(c.f, c.val, c.h)
+			assert.Equal(t, c.expectedError, err)
+			if err != nil {
+				return
+			}
+			actual := math.Abs(result - c.expectedValue)
+			assert.True(t, actual <= math.Abs(c.expectedValue)*1e-11)
+			assert.True(t, estimate < math.Abs(c.expectedValue)*1e-9)
+		})
+	}
+}
+
+func TestRiddersErrorEstimate(t *testing.T) {
+	// A step too large for the oscillation cannot be extrapolated, which has to show up in the estimate.
+	f := func(x float64) float64 {
+		return math.Sin(20 * x)
+	}
+	result, estimate, err := differentiate.Ridders(f, 0.3, 0.5)
+	assert.Nil(t, err)
+	assert.True(t, estimate > 1)
+	assert.True(t, math.Abs(result-20*math.Cos(6)) <= 10*estimate)
+
+	result, estimate, err = differentiate.Ridders(f, 0.3, 0.2)
+	assert.Nil(t, err)
+	assert.True(t, estimate < 1e-10)
+	assert.InDelta(t, 20*math.Cos(6), result, 1e-10)
+}
+
+func TestAdaptive(t *testing.T) {
+	cases := map[string]struct {
+		f             func(x float64) float64
+		val           float64
+		expectedValue float64
+	}{
+		"polynomial": {
+			f: func(x float64) float64 {
+				return math.Pow(x, 3) - 2*math.Pow(x, 2) + 5
+			},
+			val:           -1,
+			expectedValue: 7,
+		},
+		"logarithm far from the origin": {
+			f:             math.Log,
+			val:           1e4,
+			expectedValue: 1e-4,
+		},
+		"cosine near zero": {
+			f:             math.Cos,
+			val:           1e-3,
+			expectedValue: -math.Sin(1e-3),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, estimate, err := differentiate.Adaptive(c.f, c.val)
+			assert.Nil(t, err)
+			assert.InEpsilon(t, c.expectedValue, result, 1e-9)
+			assert.True(t, math.Abs(result-c.expectedValue) <= 10*estimate+1e-15)
+		})
+	}
+}
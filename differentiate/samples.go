@@ -0,0 +1,90 @@
+package differentiate
+
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Samples receives the sample points x (strictly increasing, not necessarily uniformly spaced) and the sampled values
+// y. It returns the first and the second derivative of the samples at every point, and the error (if there is any).
+// The first derivative uses three-point differences, central in the interior and one-sided at the edges, which are
+// second-order accurate on any grid, like numpy.gradient with edge_order=2. The second derivative uses the four
+// nearest points, which keeps it second-order accurate on non-uniform grids as well; with only three samples it falls
+// back to the three-point formula.
+func Samples(x, y numericalgo.Vector) (numericalgo.Vector, numericalgo.Vector, error) {
+	n := x.Dim()
+	if n != y.Dim() {
+		return nil, nil, fmt.Errorf("X and Y sizes do not match")
+	}
+	if n < 3 {
+		return nil, nil, fmt.Errorf("At least 3 samples are required")
+	}
+	for i := 1; i < n; i++ {
+		if !(x[i] > x[i-1]) {
+			return nil, nil, fmt.Errorf("X must be strictly increasing")
+		}
+	}
+
+	first := make(numericalgo.Vector, n)
+	second := make(numericalgo.Vector, n)
+	for i := 0; i < n; i++ {
+		lo := clamp(i-1, 0, n-3)
+		hi := lo + 3
+		d, err := samplesStencil(1, x, y, i, lo, hi)
+		if err != nil {
+			return nil, nil, err
+		}
+		first[i] = d
+
+		if n >= 4 {
+			lo, hi = nearestFour(x, i)
+		}
+		d, err = samplesStencil(2, x, y, i, lo, hi)
+		if err != nil {
+			return nil, nil, err
+		}
+		second[i] = d
+	}
+	return first, second, nil
+}
+
+// samplesStencil returns the m-th derivative at x[i] from the samples with indices lo to hi-1, using Fornberg weights.
+func samplesStencil(m int, x, y numericalgo.Vector, i, lo, hi int) (float64, error) {
+	w, err := FornbergWeights(m, x[i], x[lo:hi])
+	if err != nil {
+		return 0, err
+	}
+	var d float64
+	for k := range w {
+		d += w[k] * y[lo+k]
+	}
+	return d, nil
+}
+
+// nearestFour returns the index range of the four samples around x[i]: its two neighbors, plus whichever of the next
+// samples on either side is closer. At the edges the range is shifted inwards.
+func nearestFour(x numericalgo.Vector, i int) (int, int) {
+	n := x.Dim()
+	lo := i - 1
+	switch {
+	case i <= 1:
+		lo = 0
+	case i >= n-2:
+		lo = n - 4
+	case x[i]-x[i-2] < x[i+2]-x[i]:
+		lo = i - 2
+	}
+	return lo, lo + 4
+}
+
+// clamp returns v limited to the interval [lo, hi].
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,126 @@
+package differentiate_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/differentiate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSamples(t *testing.T) {
+	nonuniform := numericalgo.Vector{-1, -0.7, -0.1, 0.2, 0.9, 1.1, 2}
+
+	cases := map[string]struct {
+		x              numericalgo.Vector
+		f              func(float64) float64
+		expectedFirst  func(float64) float64
+		expectedSecond func(float64) float64
+	}{
+		"quadratic on a non-uniform grid": {
+			x:              nonuniform,
+			f:              func(x float64) float64 { return 3*x*x - 2*x + 1 },
+			expectedFirst:  func(x float64) float64 { return 6*x - 2 },
+			expectedSecond: func(x float64) float64 { return 6 },
+		},
+		"quadratic on three points": {
+			x:              numericalgo.Vector{0, 0.5, 2},
+			f:              func(x float64) float64 { return x * x },
+			expectedFirst:  func(x float64) float64 { return 2 * x },
+			expectedSecond: func(x float64) float64 { return 2 },
+		},
+		"cubic second derivative on a non-uniform grid": {
+			x:              nonuniform,
+			f:              func(x float64) float64 { return x * x * x },
+			expectedFirst:  nil,
+			expectedSecond: func(x float64) float64 { return 6 * x },
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			y := c.x.Apply(c.f)
+			first, second, err := differentiate.Samples(c.x, y)
+			assert.Nil(t, err)
+			if c.expectedFirst != nil {
+				assert.InDeltaSlice(t, c.x.Apply(c.expectedFirst), first, 1e-12)
+			}
+			assert.InDeltaSlice(t, c.x.Apply(c.expectedSecond), second, 1e-11)
+		})
+	}
+}
+
+func TestSamplesUniformMatchesCentral(t *testing.T) {
+	x := numericalgo.Vector{0, 0.1, 0.2, 0.3, 0.4}
+	y := x.Apply(math.Exp)
+	first, _, err := differentiate.Samples(x, y)
+	assert.Nil(t, err)
+
+	d, err := differentiate.Central(math.Exp, 0.2, 0.1)
+	assert.Nil(t, err)
+	assert.InDelta(t, d, first[2], 1e-14)
+	assert.InDelta(t, (-3*y[0]+4*y[1]-y[2])/0.2, first[0], 1e-12)
+}
+
+func TestSamplesSecondOrderConvergence(t *testing.T) {
+	// Halving the spacing of a stretched grid should reduce the errors of both derivatives about four times.
+	maxErrors := func(n int) (float64, float64) {
+		x := make(numericalgo.Vector, n)
+		for i := range x {
+			s := float64(i) / float64(n-1)
+			x[i] = s * s
+		}
+		first, second, _ := differentiate.Samples(x, x.Apply(math.Sin))
+		var e1, e2 float64
+		for i := range x {
+			e1 = math.Max(e1, math.Abs(first[i]-math.Cos(x[i])))
+			e2 = math.Max(e2, math.Abs(second[i]+math.Sin(x[i])))
+		}
+		return e1, e2
+	}
+
+	c1, c2 := maxErrors(21)
+	f1, f2 := maxErrors(41)
+	assert.InDelta(t, 4, c1/f1, 0.6)
+	assert.InDelta(t, 4, c2/f2, 0.6)
+}
+
+func TestSamplesErrors(t *testing.T) {
+	cases := map[string]struct {
+		x             numericalgo.Vector
+		y             numericalgo.Vector
+		expectedError error
+	}{
+		"wrong x and y size": {
+			x:             numericalgo.Vector{1, 2, 3},
+			y:             numericalgo.Vector{1, 2},
+			expectedError: fmt.Errorf("X and Y sizes do not match"),
+		},
+		"too few samples": {
+			x:             numericalgo.Vector{1, 2},
+			y:             numericalgo.Vector{1, 2},
+			expectedError: fmt.Errorf("At least 3 samples are required"),
+		},
+		"unsorted samples": {
+			x:             numericalgo.Vector{1, 3, 2},
+			y:             numericalgo.Vector{1, 2, 3},
+			expectedError: fmt.Errorf("X must be strictly increasing"),
+		},
+		"repeated samples": {
+			x:             numericalgo.Vector{1, 2, 2},
+			y:             numericalgo.Vector{1, 2, 3},
+			expectedError: fmt.Errorf("X must be strictly increasing"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			first, second, err := differentiate.Samples(c.x, c.y)
+			assert.Equal(t, c.expectedError, err)
+			assert.Nil(t, first)
+			assert.Nil(t, second)
+		})
+	}
+}
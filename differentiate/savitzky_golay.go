@@ -0,0 +1,183 @@
+package differentiate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// EdgeMode selects how SavitzkyGolay handles the points closer to the edges than half of the window.
+type EdgeMode int
+
+const (
+	// EdgeInterp fits the polynomial to the first (last) full window and evaluates it at the edge points, so no
+	// artificial data is introduced.
+	EdgeInterp EdgeMode = iota
+	// EdgeNearest extends the signal by repeating its first (last) value.
+	EdgeNearest
+	// EdgeMirror extends the signal by reflecting it about its first (last) point.
+	EdgeMirror
+)
+
+// SavitzkyGolayCoefficients receives the window size (odd), the polynomial order, the derivative order and the sample
+// spacing delta. It returns the weights c such that sum(c[k] * y[i-m+k]) over the window centered at i (m being half
+// of the window) is the derivative of the least-squares polynomial through the window at its center, and the error
+// (if there is any). With the derivative order 0 the weights smooth the signal.
+func SavitzkyGolayCoefficients(window, order, deriv int, delta float64) (numericalgo.Vector, error) {
+	if err := checkSavitzkyGolay(window, order, deriv, delta); err != nil {
+		return nil, err
+	}
+	return savitzkyGolayWeights(window, order, deriv, delta, 0)
+}
+
+// SavitzkyGolay receives the uniformly sampled signal y, the window size (odd), the polynomial order, the derivative
+// order, the sample spacing delta and the edge mode. It returns the signal smoothed (deriv = 0) or differentiated by
+// fitting a polynomial of the given order to every window with least squares, and the error (if there is any).
+// Compared with finite differences, the fit averages out the noise while preserving the shape of peaks much better
+// than a moving average of the same width.
+func SavitzkyGolay(y numericalgo.Vector, window, order, deriv int, delta float64, edge EdgeMode) (numericalgo.Vector, error) {
+	if err := checkSavitzkyGolay(window, order, deriv, delta); err != nil {
+		return nil, err
+	}
+	if edge != EdgeInterp && edge != EdgeNearest && edge != EdgeMirror {
+		return nil, fmt.Errorf("Unknown edge mode")
+	}
+
+	n := y.Dim()
+	m := window / 2
+	if n == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+	if n < window && edge == EdgeInterp {
+		return nil, fmt.Errorf("Signal must be at least as long as the window")
+	}
+	if n <= m && edge == EdgeMirror {
+		return nil, fmt.Errorf("Signal must be longer than half of the window")
+	}
+
+	c, err := savitzkyGolayWeights(window, order, deriv, delta, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(numericalgo.Vector, n)
+	for i := 0; i < n; i++ {
+		if edge == EdgeInterp && (i < m || i >= n-m) {
+			continue
+		}
+		var sum float64
+		for k := -m; k <= m; k++ {
+			sum += c[k+m] * y[edgeIndex(i+k, n, edge)]
+		}
+		r[i] = sum
+	}
+
+	if edge == EdgeInterp {
+		for i := 0; i < m; i++ {
+			r[i], err = savitzkyGolayAt(y[:window], window, order, deriv, delta, i-m)
+			if err != nil {
+				return nil, err
+			}
+			r[n-1-i], err = savitzkyGolayAt(y[n-window:], window, order, deriv, delta, m-i)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r, nil
+}
+
+// savitzkyGolayAt returns the derivative of the least-squares polynomial through the window of samples at the offset
+// t (in samples) from its center.
+func savitzkyGolayAt(y numericalgo.Vector, window, order, deriv int, delta float64, t int) (float64, error) {
+	w, err := savitzkyGolayWeights(window, order, deriv, delta, t)
+	if err != nil {
+		return 0, err
+	}
+	return w.Dot(y)
+}
+
+// checkSavitzkyGolay validates the parameters of a Savitzky-Golay filter.
+func checkSavitzkyGolay(window, order, deriv int, delta float64) error {
+	if window <= 0 || window%2 == 0 {
+		return fmt.Errorf("Window size must be a positive odd number")
+	}
+	if order < 0 || order >= window {
+		return fmt.Errorf("Polynomial order must be less than the window size")
+	}
+	if deriv < 0 || deriv > order {
+		return fmt.Errorf("Derivative order must be between 0 and the polynomial order")
+	}
+	if delta <= 0 {
+		return fmt.Errorf("Sample spacing has to be greater than 0")
+	}
+	return nil
+}
+
+// savitzkyGolayWeights returns the weights which evaluate the deriv-th derivative of the least-squares polynomial
+// through a window at the offset t (in samples) from its center. Positions are scaled by half of the window, which
+// keeps the normal equations well conditioned.
+func savitzkyGolayWeights(window, order, deriv int, delta float64, t int) (numericalgo.Vector, error) {
+	m := window / 2
+	scale := math.Max(1, float64(m))
+
+	a := make(numericalgo.Matrix, window)
+	for k := range a {
+		a[k] = make(numericalgo.Vector, order+1)
+		u := float64(k-m) / scale
+		for j := range a[k] {
+			a[k][j] = math.Pow(u, float64(j))
+		}
+	}
+
+	// b[j] is the deriv-th derivative of u^j at t, converted from scaled positions to the sample spacing.
+	u := float64(t) / scale
+	b := make(numericalgo.Vector, order+1)
+	for j := deriv; j <= order; j++ {
+		coeff := 1.0
+		for q := 0; q < deriv; q++ {
+			coeff *= float64(j - q)
+		}
+		b[j] = coeff * math.Pow(u, float64(j-deriv)) / math.Pow(scale*delta, float64(deriv))
+	}
+
+	normal := make(numericalgo.Matrix, order+1)
+	for i := range normal {
+		normal[i] = make(numericalgo.Vector, order+1)
+		for j := range normal[i] {
+			for k := range a {
+				normal[i][j] += a[k][i] * a[k][j]
+			}
+		}
+	}
+
+	lu, err := normal.LU()
+	if err != nil {
+		return nil, err
+	}
+	z, err := lu.Solve(b)
+	if err != nil {
+		return nil, err
+	}
+
+	w := make(numericalgo.Vector, window)
+	for k := range a {
+		w[k], _ = a[k].Dot(z)
+	}
+	return w, nil
+}
+
+// edgeIndex maps the index i, which may fall outside of the signal of size n, to a valid index for the edge mode.
+func edgeIndex(i, n int, edge EdgeMode) int {
+	if i >= 0 && i < n {
+		return i
+	}
+	if edge == EdgeNearest {
+		return clamp(i, 0, n-1)
+	}
+	if i < 0 {
+		return -i
+	}
+	return 2*(n-1) - i
+}
@@ -0,0 +1,208 @@
+package differentiate_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/differentiate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSavitzkyGolayCoefficients(t *testing.T) {
+	cases := map[string]struct {
+		window         int
+		order          int
+		deriv          int
+		delta          float64
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"quadratic smoothing": {
+			window:         5,
+			order:          2,
+			deriv:          0,
+			delta:          1,
+			expectedResult: numericalgo.Vector{-3.0 / 35, 12.0 / 35, 17.0 / 35, 12.0 / 35, -3.0 / 35},
+			expectedError:  nil,
+		},
+		"quadratic first derivative with spacing": {
+			window:         5,
+			order:          2,
+			deriv:          1,
+			delta:          0.5,
+			expectedResult: numericalgo.Vector{-0.4, -0.2, 0, 0.2, 0.4},
+			expectedError:  nil,
+		},
+		"quadratic second derivative": {
+			window:         5,
+			order:          2,
+			deriv:          2,
+			delta:          1,
+			expectedResult: numericalgo.Vector{2.0 / 7, -1.0 / 7, -2.0 / 7, -1.0 / 7, 2.0 / 7},
+			expectedError:  nil,
+		},
+		"order zero is a moving average": {
+			window:         3,
+			order:          0,
+			deriv:          0,
+			delta:          1,
+			expectedResult: numericalgo.Vector{1.0 / 3, 1.0 / 3, 1.0 / 3},
+			expectedError:  nil,
+		},
+		"even window": {
+			window:         4,
+			order:          2,
+			deriv:          0,
+			delta:          1,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Window size must be a positive odd number"),
+		},
+		"order too high": {
+			window:         5,
+			order:          5,
+			deriv:          0,
+			delta:          1,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Polynomial order must be less than the window size"),
+		},
+		"derivative above the order": {
+			window:         5,
+			order:          2,
+			deriv:          3,
+			delta:          1,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Derivative order must be between 0 and the polynomial order"),
+		},
+		"wrong spacing": {
+			window:         5,
+			order:          2,
+			deriv:          1,
+			delta:          0,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Sample spacing has to be greater than 0"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := differentiate.SavitzkyGolayCoefficients(c.window, c.order, c.deriv, c.delta)
+			assert.Equal(t, c.expectedError, err)
+			if c.expectedResult == nil {
+				assert.Nil(t, result)
+			} else {
+				assert.InDeltaSlice(t, c.expectedResult, result, 1e-13)
+			}
+		})
+	}
+}
+
+func TestSavitzkyGolayPreservesPolynomials(t *testing.T) {
+	// A cubic is reproduced exactly by a cubic fit, including its derivatives and the interpolated edges.
+	delta := 0.1
+	x := make(numericalgo.Vector, 20)
+	for i := range x {
+		x[i] = float64(i) * delta
+	}
+	y := x.Apply(func(x float64) float64 { return x*x*x - 2*x + 1 })
+	expected := []numericalgo.Vector{
+		y,
+		x.Apply(func(x float64) float64 { return 3*x*x - 2 }),
+		x.Apply(func(x float64) float64 { return 6 * x }),
+	}
+
+	for deriv, e := range expected {
+		t.Run(fmt.Sprintf("derivative %d", deriv), func(t *testing.T) {
+			result, err := differentiate.SavitzkyGolay(y, 7, 3, deriv, delta, differentiate.EdgeInterp)
+			assert.Nil(t, err)
+			assert.InDeltaSlice(t, e, result, 1e-9)
+		})
+	}
+}
+
+func TestSavitzkyGolayEdges(t *testing.T) {
+	y := numericalgo.Vector{1, 2, 4, 8, 16}
+
+	cases := map[string]struct {
+		edge           differentiate.EdgeMode
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"nearest": {
+			edge:           differentiate.EdgeNearest,
+			expectedResult: numericalgo.Vector{4.0 / 3, 7.0 / 3, 14.0 / 3, 28.0 / 3, 40.0 / 3},
+			expectedError:  nil,
+		},
+		"mirror": {
+			edge:           differentiate.EdgeMirror,
+			expectedResult: numericalgo.Vector{5.0 / 3, 7.0 / 3, 14.0 / 3, 28.0 / 3, 32.0 / 3},
+			expectedError:  nil,
+		},
+		"interp": {
+			edge:           differentiate.EdgeInterp,
+			expectedResult: numericalgo.Vector{7.0 / 3, 7.0 / 3, 14.0 / 3, 28.0 / 3, 28.0 / 3},
+			expectedError:  nil,
+		},
+		"unknown": {
+			edge:           differentiate.EdgeMode(9),
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Unknown edge mode"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := differentiate.SavitzkyGolay(y, 3, 0, 0, 1, c.edge)
+			assert.Equal(t, c.expectedError, err)
+			if c.expectedResult == nil {
+				assert.Nil(t, result)
+			} else {
+				assert.InDeltaSlice(t, c.expectedResult, result, 1e-12)
+			}
+		})
+	}
+}
+
+func TestSavitzkyGolayErrors(t *testing.T) {
+	_, err := differentiate.SavitzkyGolay(numericalgo.Vector{1, 2, 3}, 5, 2, 0, 1, differentiate.EdgeInterp)
+	assert.Equal(t, fmt.Errorf("Signal must be at least as long as the window"), err)
+
+	_, err = differentiate.SavitzkyGolay(numericalgo.Vector{1, 2}, 5, 2, 0, 1, differentiate.EdgeMirror)
+	assert.Equal(t, fmt.Errorf("Signal must be longer than half of the window"), err)
+
+	_, err = differentiate.SavitzkyGolay(numericalgo.Vector{}, 5, 2, 0, 1, differentiate.EdgeNearest)
+	assert.Equal(t, fmt.Errorf("Vector cannot be empty"), err)
+}
+
+func TestSavitzkyGolayNoisyDerivative(t *testing.T) {
+	rnd := rand.New(rand.NewSource(7))
+	delta := 0.01
+	n := 300
+	x := make(numericalgo.Vector, n)
+	y := make(numericalgo.Vector, n)
+	for i := range x {
+		x[i] = float64(i) * delta
+		y[i] = math.Sin(x[i]) + 1e-3*rnd.NormFloat64()
+	}
+
+	raw, _, err := differentiate.Samples(x, y)
+	assert.Nil(t, err)
+	smooth, err := differentiate.SavitzkyGolay(y, 41, 3, 1, delta, differentiate.EdgeInterp)
+	assert.Nil(t, err)
+
+	rawErr, smoothErr := rmsError(raw, x, math.Cos), rmsError(smooth, x, math.Cos)
+	assert.True(t, smoothErr < rawErr/10)
+	assert.True(t, smoothErr < 0.01)
+}
+
+// rmsError returns the root-mean-square difference between the values and f at the points x.
+func rmsError(values, x numericalgo.Vector, f func(float64) float64) float64 {
+	var sum float64
+	for i := range x {
+		d := values[i] - f(x[i])
+		sum += d * d
+	}
+	return math.Sqrt(sum / float64(x.Dim()))
+}
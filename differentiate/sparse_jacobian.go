@@ -0,0 +1,200 @@
+package differentiate
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Sparsity is the sparsity pattern of a Jacobian: Sparsity[i] lists the columns j for which df_i/dx_j may be nonzero.
+type Sparsity [][]int
+
+// Entry is a single entry of a SparseMatrix.
+type Entry struct {
+	Row   int
+	Col   int
+	Value float64
+}
+
+// SparseMatrix is a matrix in coordinate form, which stores only the entries of its sparsity pattern. The entries
+// are sorted by row and then by column.
+type SparseMatrix struct {
+	Rows    int
+	Cols    int
+	Entries []Entry
+}
+
+// Dense returns the SparseMatrix as a dense Matrix, with zeros outside of its entries.
+func (s *SparseMatrix) Dense() numericalgo.Matrix {
+	m := make(numericalgo.Matrix, s.Rows)
+	for i := range m {
+		m[i] = make(numericalgo.Vector, s.Cols)
+	}
+	for _, e := range s.Entries {
+		m[e.Row][e.Col] = e.Value
+	}
+	return m
+}
+
+// ColorColumns receives the sparsity pattern and the number of columns. It returns the color of every column, such
+// that no two columns of the same color have a nonzero in the same row, the number of colors, and the error (if there
+// is any). Columns are colored greedily with the smallest free color, in the order of decreasing number of nonzeros
+// (the largest-first heuristic). For a banded pattern the number of colors equals the bandwidth, independent of the
+// number of columns.
+func ColorColumns(pattern Sparsity, cols int) ([]int, int, error) {
+	colRows, err := columnRows(pattern, cols)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := make([]int, cols)
+	for j := range order {
+		order[j] = j
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return len(colRows[order[a]]) > len(colRows[order[b]])
+	})
+
+	colors := make([]int, cols)
+	for j := range colors {
+		colors[j] = -1
+	}
+	// forbidden[c] == j+1 marks color c as used by a neighbor of column j.
+	var forbidden []int
+	count := 0
+	for _, j := range order {
+		for _, row := range colRows[j] {
+			for _, k := range pattern[row] {
+				if colors[k] >= 0 {
+					forbidden[colors[k]] = j + 1
+				}
+			}
+		}
+		c := 0
+		for c < count && forbidden[c] == j+1 {
+			c++
+		}
+		if c == count {
+			count++
+			forbidden = append(forbidden, 0)
+		}
+		colors[j] = c
+	}
+	return colors, count, nil
+}
+
+// SparseJacobian receives a vector function of a vector, the point x and the sparsity pattern of the Jacobian. It
+// returns the Jacobian at x computed with forward differences as a SparseMatrix, and the error (if there is any).
+func SparseJacobian(f func(numericalgo.Vector) numericalgo.Vector, x numericalgo.Vector, pattern Sparsity) (*SparseMatrix, error) {
+	return SparseJacobianWith(f, x, pattern, ForwardDifference)
+}
+
+// SparseJacobianWith receives a vector function of a vector, the point x, the sparsity pattern of the Jacobian and the
+// difference scheme. It returns the Jacobian at x as a SparseMatrix, and the error (if there is any). The columns are
+// grouped with ColorColumns and all the columns of a group are perturbed at once, so the forward scheme needs one
+// function evaluation per color plus one, and the central scheme two per color, instead of one or two per column.
+// Entries outside of the pattern must be zero, otherwise they corrupt the entries of the columns sharing their color.
+func SparseJacobianWith(f func(numericalgo.Vector) numericalgo.Vector, x numericalgo.Vector, pattern Sparsity, scheme Scheme) (*SparseMatrix, error) {
+	n := x.Dim()
+	if n == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+	if scheme != CentralDifference && scheme != ForwardDifference {
+		return nil, fmt.Errorf("Unknown difference scheme")
+	}
+
+	colors, count, err := ColorColumns(pattern, n)
+	if err != nil {
+		return nil, err
+	}
+
+	m := len(pattern)
+	var fx numericalgo.Vector
+	if scheme == ForwardDifference {
+		fx = f(copyVector(x))
+		if fx.Dim() != m {
+			return nil, fmt.Errorf("Function output sizes do not match")
+		}
+	}
+
+	hs := make(numericalgo.Vector, n)
+	for j := range x {
+		hs[j] = step(x[j], scheme, 1)
+	}
+
+	// diffs[c] holds the differences of the function values for the columns of color c.
+	diffs := make([]numericalgo.Vector, count)
+	for c := 0; c < count; c++ {
+		fHi := f(perturbed(x, hs, colors, c, 1))
+		fLo := fx
+		if scheme == CentralDifference {
+			fLo = f(perturbed(x, hs, colors, c, -1))
+		}
+		if fHi.Dim() != m || fLo.Dim() != m {
+			return nil, fmt.Errorf("Function output sizes do not match")
+		}
+
+		diffs[c], err = fHi.Subtract(fLo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s := &SparseMatrix{Rows: m, Cols: n}
+	for i, row := range pattern {
+		for _, j := range uniqueSorted(row) {
+			div := hs[j]
+			if scheme == CentralDifference {
+				div *= 2
+			}
+			s.Entries = append(s.Entries, Entry{Row: i, Col: j, Value: diffs[colors[j]][i] / div})
+		}
+	}
+	return s, nil
+}
+
+// perturbed returns a copy of x in which every coordinate j of the color c is moved by sign*hs[j].
+func perturbed(x, hs numericalgo.Vector, colors []int, c int, sign float64) numericalgo.Vector {
+	r := copyVector(x)
+	for j := range r {
+		if colors[j] == c {
+			r[j] += sign * hs[j]
+		}
+	}
+	return r
+}
+
+// columnRows validates the pattern and returns, for every column, the rows in which it has a nonzero.
+func columnRows(pattern Sparsity, cols int) ([][]int, error) {
+	if cols <= 0 {
+		return nil, fmt.Errorf("Number of columns must be positive")
+	}
+
+	colRows := make([][]int, cols)
+	for i, row := range pattern {
+		for _, j := range uniqueSorted(row) {
+			if j < 0 || j >= cols {
+				return nil, fmt.Errorf("Sparsity pattern column index out of range")
+			}
+			colRows[j] = append(colRows[j], i)
+		}
+	}
+	return colRows, nil
+}
+
+// uniqueSorted returns the sorted indices without duplicates.
+func uniqueSorted(idx []int) []int {
+	r := make([]int, len(idx))
+	copy(r, idx)
+	sort.Ints(r)
+
+	k := 0
+	for i, v := range r {
+		if i == 0 || v != r[k-1] {
+			r[k] = v
+			k++
+		}
+	}
+	return r[:k]
+}
@@ -0,0 +1,210 @@
+package differentiate_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/differentiate"
+	"github.com/stretchr/testify/assert"
+)
+
+// banded returns the pattern of an n x n matrix with the given number of diagonals below and above the main one.
+func banded(n, lower, upper int) differentiate.Sparsity {
+	p := make(differentiate.Sparsity, n)
+	for i := range p {
+		for j := i - lower; j <= i+upper; j++ {
+			if j >= 0 && j < n {
+				p[i] = append(p[i], j)
+			}
+		}
+	}
+	return p
+}
+
+// assertValidColoring checks that no two columns of the same color share a row.
+func assertValidColoring(t *testing.T, pattern differentiate.Sparsity, colors []int) {
+	for _, row := range pattern {
+		seen := make(map[int]int)
+		for _, j := range row {
+			if k, ok := seen[colors[j]]; ok && k != j {
+				t.Errorf("Columns %d and %d share a row and the color %d", k, j, colors[j])
+			}
+			seen[colors[j]] = j
+		}
+	}
+}
+
+func TestColorColumns(t *testing.T) {
+	cases := map[string]struct {
+		pattern        differentiate.Sparsity
+		cols           int
+		expectedColors int
+		expectedError  error
+	}{
+		"diagonal": {
+			pattern:        banded(50, 0, 0),
+			cols:           50,
+			expectedColors: 1,
+			expectedError:  nil,
+		},
+		"tridiagonal": {
+			pattern:        banded(1000, 1, 1),
+			cols:           1000,
+			expectedColors: 3,
+			expectedError:  nil,
+		},
+		"pentadiagonal": {
+			pattern:        banded(200, 2, 2),
+			cols:           200,
+			expectedColors: 5,
+			expectedError:  nil,
+		},
+		"arrowhead": {
+			// A dense first row forces distinct colors for all columns.
+			pattern:        differentiate.Sparsity{{0, 1, 2, 3}, {0, 1}, {0, 2}, {0, 3}},
+			cols:           4,
+			expectedColors: 4,
+			expectedError:  nil,
+		},
+		"rectangular with duplicates": {
+			pattern:        differentiate.Sparsity{{0, 0, 2}, {1, 3}},
+			cols:           4,
+			expectedColors: 2,
+			expectedError:  nil,
+		},
+		"column out of range": {
+			pattern:        differentiate.Sparsity{{0, 4}},
+			cols:           4,
+			expectedColors: 0,
+			expectedError:  fmt.Errorf("Sparsity pattern column index out of range"),
+		},
+		"no columns": {
+			pattern:        differentiate.Sparsity{},
+			cols:           0,
+			expectedColors: 0,
+			expectedError:  fmt.Errorf("Number of columns must be positive"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			colors, count, err := differentiate.ColorColumns(c.pattern, c.cols)
+			assert.Equal(t, c.expectedError, err)
+			assert.Equal(t, c.expectedColors, count)
+			if err == nil {
+				assert.Equal(t, c.cols, len(colors))
+				assertValidColoring(t, c.pattern, colors)
+			}
+		})
+	}
+}
+
+// discreteBratu is the residual of u” + exp(u) = 0 with zero boundary values on a uniform grid, whose Jacobian is
+// tridiagonal. It counts its evaluations in calls.
+func discreteBratu(calls *int) func(numericalgo.Vector) numericalgo.Vector {
+	return func(u numericalgo.Vector) numericalgo.Vector {
+		*calls++
+		n := u.Dim()
+		h := 1 / float64(n+1)
+		r := make(numericalgo.Vector, n)
+		for i := range u {
+			var left, right float64
+			if i > 0 {
+				left = u[i-1]
+			}
+			if i < n-1 {
+				right = u[i+1]
+			}
+			r[i] = (left-2*u[i]+right)/(h*h) + math.Exp(u[i])
+		}
+		return r
+	}
+}
+
+func TestSparseJacobian(t *testing.T) {
+	n := 1000
+	x := make(numericalgo.Vector, n)
+	for i := range x {
+		x[i] = math.Sin(math.Pi * float64(i+1) / float64(n+1))
+	}
+	h := 1 / float64(n+1)
+
+	cases := map[string]struct {
+		scheme        differentiate.Scheme
+		expectedCalls int
+		tol           float64
+	}{
+		"forward": {
+			scheme:        differentiate.ForwardDifference,
+			expectedCalls: 4,
+			tol:           1e-5,
+		},
+		"central": {
+			scheme:        differentiate.CentralDifference,
+			expectedCalls: 6,
+			tol:           1e-8,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			s, err := differentiate.SparseJacobianWith(discreteBratu(&calls), x, banded(n, 1, 1), c.scheme)
+			assert.Nil(t, err)
+			assert.Equal(t, c.expectedCalls, calls)
+			assert.Equal(t, 3*n-2, len(s.Entries))
+
+			for _, e := range s.Entries {
+				expected := 1 / (h * h)
+				if e.Row == e.Col {
+					expected = -2/(h*h) + math.Exp(x[e.Row])
+				}
+				assert.InEpsilon(t, expected, e.Value, c.tol)
+			}
+		})
+	}
+}
+
+func TestSparseJacobianMatchesDense(t *testing.T) {
+	// f(x) = (x0*x1, x1^2 + sin(x2), x0*x3, exp(x3))
+	f := func(v numericalgo.Vector) numericalgo.Vector {
+		return numericalgo.Vector{v[0] * v[1], v[1]*v[1] + math.Sin(v[2]), v[0] * v[3], math.Exp(v[3])}
+	}
+	pattern := differentiate.Sparsity{{0, 1}, {1, 2}, {0, 3}, {3}}
+	x := numericalgo.Vector{0.5, -1, 2, 0.3}
+
+	s, err := differentiate.SparseJacobianWith(f, x, pattern, differentiate.CentralDifference)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, s.Rows)
+	assert.Equal(t, 4, s.Cols)
+
+	dense, err := differentiate.Jacobian(f, x)
+	assert.Nil(t, err)
+	sparse := s.Dense()
+	for i := range dense {
+		assert.InDeltaSlice(t, dense[i], sparse[i], 1e-9)
+	}
+	assert.Equal(t, 7, len(s.Entries))
+	assert.Equal(t, 1, s.Entries[1].Col)
+	assert.InDelta(t, 0.5, s.Entries[1].Value, 1e-9)
+	assert.Equal(t, 0.0, sparse[3][0])
+}
+
+func TestSparseJacobianErrors(t *testing.T) {
+	calls := 0
+	f := discreteBratu(&calls)
+
+	_, err := differentiate.SparseJacobian(f, numericalgo.Vector{}, banded(3, 1, 1))
+	assert.Equal(t, fmt.Errorf("Vector cannot be empty"), err)
+
+	_, err = differentiate.SparseJacobian(f, numericalgo.Vector{1, 2, 3}, banded(4, 1, 1))
+	assert.Equal(t, fmt.Errorf("Sparsity pattern column index out of range"), err)
+
+	_, err = differentiate.SparseJacobian(f, numericalgo.Vector{1, 2, 3}, banded(3, 1, 1)[:2])
+	assert.Equal(t, fmt.Errorf("Function output sizes do not match"), err)
+
+	_, err = differentiate.SparseJacobianWith(f, numericalgo.Vector{1, 2, 3}, banded(3, 1, 1), differentiate.Scheme(5))
+	assert.Equal(t, fmt.Errorf("Unknown difference scheme"), err)
+}
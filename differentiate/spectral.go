@@ -0,0 +1,149 @@
+package differentiate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// ChebyshevPoints receives the number of intervals n, and returns the n+1 Chebyshev-Gauss-Lobatto points
+// cos(pi*j/n), j = 0..n, which run from 1 down to -1, and the error (if there is any). Points on an interval [a, b]
+// are obtained as a + (b-a)*(x+1)/2.
+func ChebyshevPoints(n int) (numericalgo.Vector, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("Number of intervals must be positive")
+	}
+
+	x := make(numericalgo.Vector, n+1)
+	for j := range x {
+		// The sine form is exactly antisymmetric about zero, unlike cos(pi*j/n).
+		x[j] = math.Sin(math.Pi * float64(n-2*j) / float64(2*n))
+	}
+	return x, nil
+}
+
+// ChebyshevMatrix receives the number of intervals n and the derivative order. It returns the (n+1)x(n+1) matrix D
+// such that D*u holds the derivative of the given order, at the ChebyshevPoints, of the polynomial interpolating the
+// values u at those points, and the error (if there is any). For smooth functions the error decreases exponentially
+// with n. On an interval [a, b] the matrix has to be multiplied by (2/(b-a))^order.
+func ChebyshevMatrix(n, order int) (numericalgo.Matrix, error) {
+	if order < 0 {
+		return nil, fmt.Errorf("Derivative order cannot be negative")
+	}
+	x, err := ChebyshevPoints(n)
+	if err != nil {
+		return nil, err
+	}
+
+	d := make(numericalgo.Matrix, n+1)
+	for i := range d {
+		d[i] = make(numericalgo.Vector, n+1)
+		var diag float64
+		for j := range d[i] {
+			if i == j {
+				continue
+			}
+			d[i][j] = chebyshevWeight(i, n) / chebyshevWeight(j, n) / (x[i] - x[j])
+			if (i+j)%2 == 1 {
+				d[i][j] = -d[i][j]
+			}
+			diag -= d[i][j]
+		}
+		// Taking the diagonal as the negative row sum makes constants differentiate to zero exactly.
+		d[i][i] = diag
+	}
+
+	return matrixPower(d, order)
+}
+
+// chebyshevWeight returns 2 for the end points and 1 for the interior points of the Chebyshev grid.
+func chebyshevWeight(j, n int) float64 {
+	if j == 0 || j == n {
+		return 2
+	}
+	return 1
+}
+
+// FourierPoints receives the number of points n and the period length. It returns the n equispaced points
+// j*length/n, j = 0..n-1, of a periodic grid, and the error (if there is any).
+func FourierPoints(n int, length float64) (numericalgo.Vector, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("Number of points must be positive")
+	}
+	if length <= 0 {
+		return nil, fmt.Errorf("Period has to be greater than 0")
+	}
+
+	x := make(numericalgo.Vector, n)
+	for j := range x {
+		x[j] = float64(j) * length / float64(n)
+	}
+	return x, nil
+}
+
+// FourierMatrix receives the number of points n, the derivative order and the period length. It returns the n x n
+// matrix D such that D*u holds the derivative of the given order, at the FourierPoints, of the trigonometric
+// polynomial interpolating the periodic values u, and the error (if there is any). For even n the odd derivatives of
+// the highest (Nyquist) mode are taken to be zero, as is usual, so that the matrix stays real.
+func FourierMatrix(n, order int, length float64) (numericalgo.Matrix, error) {
+	if order < 0 {
+		return nil, fmt.Errorf("Derivative order cannot be negative")
+	}
+	if _, err := FourierPoints(n, length); err != nil {
+		return nil, err
+	}
+
+	m := float64(order)
+	h := 2 * math.Pi / float64(n)
+	scale := math.Pow(2*math.Pi/length, m)
+
+	// row[k] is the entry for the index difference k, since the matrix is circulant.
+	row := make(numericalgo.Vector, n)
+	for diff := range row {
+		theta := float64(diff) * h
+		var sum float64
+		if order == 0 {
+			sum = 1
+		}
+		for k := 1; 2*k < n; k++ {
+			kk := float64(k)
+			if order%2 == 0 {
+				sum += 2 * math.Pow(-1, m/2) * math.Pow(kk, m) * math.Cos(kk*theta)
+			} else {
+				sum += 2 * math.Pow(-1, (m+1)/2) * math.Pow(kk, m) * math.Sin(kk*theta)
+			}
+		}
+		if n%2 == 0 && order%2 == 0 {
+			nyquist := float64(n / 2)
+			sum += math.Pow(-1, m/2) * math.Pow(nyquist, m) * math.Cos(nyquist*theta)
+		}
+		row[diff] = scale * sum / float64(n)
+	}
+
+	d := make(numericalgo.Matrix, n)
+	for i := range d {
+		d[i] = make(numericalgo.Vector, n)
+		for j := range d[i] {
+			d[i][j] = row[((i-j)%n+n)%n]
+		}
+	}
+	return d, nil
+}
+
+// matrixPower returns the square matrix d raised to the non-negative integer power p.
+func matrixPower(d numericalgo.Matrix, p int) (numericalgo.Matrix, error) {
+	r := make(numericalgo.Matrix, len(d))
+	for i := range r {
+		r[i] = make(numericalgo.Vector, len(d))
+		r[i][i] = 1
+	}
+	for k := 0; k < p; k++ {
+		var err error
+		r, err = r.MultiplyBy(d)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,203 @@
+package differentiate_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/differentiate"
+	"github.com/stretchr/testify/assert"
+)
+
+// applyMatrix returns the product of the matrix and the vector.
+func applyMatrix(d numericalgo.Matrix, u numericalgo.Vector) numericalgo.Vector {
+	r := make(numericalgo.Vector, len(d))
+	for i := range d {
+		r[i], _ = d[i].Dot(u)
+	}
+	return r
+}
+
+func TestChebyshevPoints(t *testing.T) {
+	x, err := differentiate.ChebyshevPoints(4)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, numericalgo.Vector{1, math.Sqrt2 / 2, 0, -math.Sqrt2 / 2, -1}, x, 1e-15)
+	assert.Equal(t, 0.0, x[2])
+	assert.Equal(t, -x[1], x[3])
+
+	_, err = differentiate.ChebyshevPoints(0)
+	assert.Equal(t, fmt.Errorf("Number of intervals must be positive"), err)
+}
+
+func TestChebyshevMatrix(t *testing.T) {
+	cases := map[string]struct {
+		n        int
+		order    int
+		f        func(float64) float64
+		expected func(float64) float64
+		tol      float64
+	}{
+		"first derivative of a quadratic is exact": {
+			n:        2,
+			order:    1,
+			f:        func(x float64) float64 { return x * x },
+			expected: func(x float64) float64 { return 2 * x },
+			tol:      1e-14,
+		},
+		"first derivative of the exponential": {
+			n:        16,
+			order:    1,
+			f:        math.Exp,
+			expected: math.Exp,
+			tol:      1e-12,
+		},
+		"second derivative of a sine": {
+			n:        20,
+			order:    2,
+			f:        func(x float64) float64 { return math.Sin(2 * x) },
+			expected: func(x float64) float64 { return -4 * math.Sin(2*x) },
+			tol:      1e-9,
+		},
+		"third derivative of a quartic": {
+			n:        6,
+			order:    3,
+			f:        func(x float64) float64 { return math.Pow(x, 4) },
+			expected: func(x float64) float64 { return 24 * x },
+			tol:      1e-11,
+		},
+		"order zero is the identity": {
+			n:        5,
+			order:    0,
+			f:        math.Cos,
+			expected: math.Cos,
+			tol:      0,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			x, _ := differentiate.ChebyshevPoints(c.n)
+			d, err := differentiate.ChebyshevMatrix(c.n, c.order)
+			assert.Nil(t, err)
+			assert.Equal(t, c.n+1, len(d))
+			assert.InDeltaSlice(t, x.Apply(c.expected), applyMatrix(d, x.Apply(c.f)), c.tol)
+		})
+	}
+}
+
+func TestChebyshevMatrixSmallest(t *testing.T) {
+	d, err := differentiate.ChebyshevMatrix(1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Matrix{{0.5, -0.5}, {0.5, -0.5}}, d)
+
+	_, err = differentiate.ChebyshevMatrix(4, -1)
+	assert.Equal(t, fmt.Errorf("Derivative order cannot be negative"), err)
+}
+
+func TestChebyshevSpectralConvergence(t *testing.T) {
+	maxError := func(n int) float64 {
+		x, _ := differentiate.ChebyshevPoints(n)
+		d, _ := differentiate.ChebyshevMatrix(n, 1)
+		f := func(x float64) float64 { return 1 / (1 + 4*x*x) }
+		df := func(x float64) float64 { return -8 * x / math.Pow(1+4*x*x, 2) }
+		var e float64
+		for i, v := range applyMatrix(d, x.Apply(f)) {
+			e = math.Max(e, math.Abs(v-df(x[i])))
+		}
+		return e
+	}
+
+	// The error of the analytic function falls by orders of magnitude as n doubles.
+	assert.True(t, maxError(40) < maxError(20)*1e-3)
+	assert.True(t, maxError(40) < 1e-6)
+}
+
+func TestFourierPoints(t *testing.T) {
+	x, err := differentiate.FourierPoints(4, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Vector{0, 0.5, 1, 1.5}, x)
+
+	_, err = differentiate.FourierPoints(0, 1)
+	assert.Equal(t, fmt.Errorf("Number of points must be positive"), err)
+
+	_, err = differentiate.FourierPoints(4, 0)
+	assert.Equal(t, fmt.Errorf("Period has to be greater than 0"), err)
+}
+
+func TestFourierMatrix(t *testing.T) {
+	cases := map[string]struct {
+		n        int
+		order    int
+		length   float64
+		f        func(float64) float64
+		expected func(float64) float64
+		tol      float64
+	}{
+		"first derivative on an even grid": {
+			n:        16,
+			order:    1,
+			length:   2 * math.Pi,
+			f:        func(x float64) float64 { return math.Sin(3 * x) },
+			expected: func(x float64) float64 { return 3 * math.Cos(3*x) },
+			tol:      1e-12,
+		},
+		"first derivative on an odd grid": {
+			n:        15,
+			order:    1,
+			length:   2 * math.Pi,
+			f:        func(x float64) float64 { return math.Sin(3 * x) },
+			expected: func(x float64) float64 { return 3 * math.Cos(3*x) },
+			tol:      1e-12,
+		},
+		"second derivative on a unit period": {
+			n:        12,
+			order:    2,
+			length:   1,
+			f:        func(x float64) float64 { return math.Cos(2 * math.Pi * x) },
+			expected: func(x float64) float64 { return -4 * math.Pi * math.Pi * math.Cos(2*math.Pi*x) },
+			tol:      1e-10,
+		},
+		"third derivative of a smooth periodic function": {
+			n:      32,
+			order:  3,
+			length: 2 * math.Pi,
+			f:      func(x float64) float64 { return math.Exp(math.Sin(x)) },
+			expected: func(x float64) float64 {
+				s, c := math.Sincos(x)
+				return math.Exp(s) * c * (c*c - 3*s - 1)
+			},
+			tol: 1e-9,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			x, _ := differentiate.FourierPoints(c.n, c.length)
+			d, err := differentiate.FourierMatrix(c.n, c.order, c.length)
+			assert.Nil(t, err)
+			assert.Equal(t, c.n, len(d))
+			assert.InDeltaSlice(t, x.Apply(c.expected), applyMatrix(d, x.Apply(c.f)), c.tol)
+		})
+	}
+}
+
+func TestFourierMatrixTrefethen(t *testing.T) {
+	// For even n the first derivative matrix is 0.5 * (-1)^(i-j) * cot((i-j)*h/2).
+	n := 8
+	h := 2 * math.Pi / float64(n)
+	d, err := differentiate.FourierMatrix(n, 1, 2*math.Pi)
+	assert.Nil(t, err)
+	for i := 0; i < n; i++ {
+		assert.InDelta(t, 0, d[i][i], 1e-15)
+		for j := 0; j < n; j++ {
+			if i != j {
+				expected := 0.5 * math.Pow(-1, float64(i-j)) / math.Tan(float64(i-j)*h/2)
+				assert.InDelta(t, expected, d[i][j], 1e-14)
+			}
+		}
+	}
+
+	_, err = differentiate.FourierMatrix(8, -2, 1)
+	assert.Equal(t, fmt.Errorf("Derivative order cannot be negative"), err)
+}
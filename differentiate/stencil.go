@@ -0,0 +1,128 @@
+package differentiate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// FornbergWeights receives the derivative order m, the point x0 and the nodes x. It returns the weights w such that
+// sum(w_i * f(x_i)) approximates the m-th derivative of f at x0, and the error (if there is any). The weights are
+// generated with Fornberg's recursion, which works for any set of distinct nodes, uniform or not, and is exact for
+// polynomials of degree below len(x).
+func FornbergWeights(m int, x0 float64, x numericalgo.Vector) (numericalgo.Vector, error) {
+	if m < 0 {
+		return nil, fmt.Errorf("Derivative order cannot be negative")
+	}
+	n := x.Dim()
+	if n <= m {
+		return nil, fmt.Errorf("Stencil must have more points than the derivative order")
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if x[i] == x[j] {
+				return nil, fmt.Errorf("Stencil points must be distinct")
+			}
+		}
+	}
+
+	// c[i][k] holds the weight of node i for the k-th derivative.
+	c := make(numericalgo.Matrix, n)
+	for i := range c {
+		c[i] = make(numericalgo.Vector, m+1)
+	}
+	c[0][0] = 1
+
+	c1 := 1.0
+	c4 := x[0] - x0
+	for i := 1; i < n; i++ {
+		mn := i
+		if m < mn {
+			mn = m
+		}
+		c2 := 1.0
+		c5 := c4
+		c4 = x[i] - x0
+		for j := 0; j < i; j++ {
+			c3 := x[i] - x[j]
+			c2 *= c3
+			if j == i-1 {
+				for k := mn; k > 0; k-- {
+					c[i][k] = c1 * (float64(k)*c[i-1][k-1] - c5*c[i-1][k]) / c2
+				}
+				c[i][0] = -c1 * c5 * c[i-1][0] / c2
+			}
+			for k := mn; k > 0; k-- {
+				c[j][k] = (c4*c[j][k] - float64(k)*c[j][k-1]) / c3
+			}
+			c[j][0] = c4 * c[j][0] / c3
+		}
+		c1 = c2
+	}
+
+	w := make(numericalgo.Vector, n)
+	for i := range w {
+		w[i] = c[i][m]
+	}
+	return w, nil
+}
+
+// Stencil receives the function, the value, the step size h, the stencil offsets (in units of h) and the derivative
+// order m. It returns the approximation of the m-th derivative of the function at the value, computed from the
+// function values at val + offsets[i]*h with Fornberg weights, and the error (if there is any). For example, offsets
+// {-1, 0, 1} with m = 2 give the usual three-point second derivative, and {0, 1, 2} with m = 1 a one-sided formula.
+func Stencil(f func(float64) float64, val, h float64, offsets numericalgo.Vector, m int) (float64, error) {
+	if h <= 0 {
+		return 0, fmt.Errorf("Step size has to be greater than 0")
+	}
+
+	w, err := FornbergWeights(m, 0, offsets)
+	if err != nil {
+		return 0, err
+	}
+
+	var sum float64
+	for i, o := range offsets {
+		if w[i] != 0 {
+			sum += w[i] * f(val+o*h)
+		}
+	}
+	return sum / math.Pow(h, float64(m)), nil
+}
+
+// Second receives the function, the value and the step size h. It returns the second derivative of the function at
+// the value using the three-point central formula, which is second-order accurate, and the error (if there is any).
+func Second(f func(float64) float64, val, h float64) (float64, error) {
+	if h <= 0 {
+		return 0, fmt.Errorf("Step size has to be greater than 0")
+	}
+	return (f(val+h) - 2*f(val) + f(val-h)) / (h * h), nil
+}
+
+// Second4 receives the function, the value and the step size h. It returns the second derivative of the function at
+// the value using the five-point central formula, which is fourth-order accurate, and the error (if there is any).
+func Second4(f func(float64) float64, val, h float64) (float64, error) {
+	if h <= 0 {
+		return 0, fmt.Errorf("Step size has to be greater than 0")
+	}
+	return (-f(val+2*h) + 16*f(val+h) - 30*f(val) + 16*f(val-h) - f(val-2*h)) / (12 * h * h), nil
+}
+
+// Central4 receives the function, the value and the step size h. It returns the first derivative of the function at
+// the value using the five-point central formula, which is fourth-order accurate, and the error (if there is any).
+func Central4(f func(float64) float64, val, h float64) (float64, error) {
+	if h <= 0 {
+		return 0, fmt.Errorf("Step size has to be greater than 0")
+	}
+	return (f(val-2*h) - 8*f(val-h) + 8*f(val+h) - f(val+2*h)) / (12 * h), nil
+}
+
+// Central6 receives the function, the value and the step size h. It returns the first derivative of the function at
+// the value using the seven-point central formula, which is sixth-order accurate, and the error (if there is any).
+func Central6(f func(float64) float64, val, h float64) (float64, error) {
+	if h <= 0 {
+		return 0, fmt.Errorf("Step size has to be greater than 0")
+	}
+	return (-f(val-3*h) + 9*f(val-2*h) - 45*f(val-h) + 45*f(val+h) - 9*f(val+2*h) + f(val+3*h)) / (60 * h), nil
+}
@@ -0,0 +1,214 @@
+package differentiate_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/differentiate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFornbergWeights(t *testing.T) {
+	cases := map[string]struct {
+		m              int
+		x0             float64
+		x              numericalgo.Vector
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"three-point second derivative": {
+			m:              2,
+			x0:             0,
+			x:              numericalgo.Vector{-1, 0, 1},
+			expectedResult: numericalgo.Vector{1, -2, 1},
+			expectedError:  nil,
+		},
+		"five-point first derivative": {
+			m:              1,
+			x0:             0,
+			x:              numericalgo.Vector{-2, -1, 0, 1, 2},
+			expectedResult: numericalgo.Vector{1.0 / 12, -2.0 / 3, 0, 2.0 / 3, -1.0 / 12},
+			expectedError:  nil,
+		},
+		"one-sided first derivative": {
+			m:              1,
+			x0:             0,
+			x:              numericalgo.Vector{0, 1, 2},
+			expectedResult: numericalgo.Vector{-1.5, 2, -0.5},
+			expectedError:  nil,
+		},
+		"interpolation weights at a shifted point": {
+			m:              0,
+			x0:             1.5,
+			x:              numericalgo.Vector{1, 2},
+			expectedResult: numericalgo.Vector{0.5, 0.5},
+			expectedError:  nil,
+		},
+		"nonuniform nodes": {
+			m:              2,
+			x0:             0,
+			x:              numericalgo.Vector{-1, 0, 2},
+			expectedResult: numericalgo.Vector{2.0 / 3, -1, 1.0 / 3},
+			expectedError:  nil,
+		},
+		"too few points": {
+			m:              2,
+			x0:             0,
+			x:              numericalgo.Vector{-1, 1},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Stencil must have more points than the derivative order"),
+		},
+		"repeated points": {
+			m:              1,
+			x0:             0,
+			x:              numericalgo.Vector{-1, 1, 1},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Stencil points must be distinct"),
+		},
+		"negative order": {
+			m:              -1,
+			x0:             0,
+			x:              numericalgo.Vector{-1, 1},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Derivative order cannot be negative"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := differentiate.FornbergWeights(c.m, c.x0, c.x)
+			assert.Equal(t, c.expectedError, err)
+			if c.expectedResult == nil {
+				assert.Nil(t, result)
+			} else {
+				assert.InDeltaSlice(t, c.expectedResult, result, 1e-14)
+			}
+		})
+	}
+}
+
+func TestStencil(t *testing.T) {
+	f := func(x float64) float64 {
+		return math.Sin(x) * math.Exp(x)
+	}
+	// d/dx = e^x (sin x + cos x), d2/dx2 = 2 e^x cos x, d3/dx3 = 2 e^x (cos x - sin x).
+	val := 0.7
+
+	cases := map[string]struct {
+		offsets       numericalgo.Vector
+		m             int
+		h             float64
+		expectedValue float64
+		tol           float64
+		expectedError error
+	}{
+		"central first derivative": {
+			offsets:       numericalgo.Vector{-2, -1, 0, 1, 2},
+			m:             1,
+			h:             0.01,
+			expectedValue: math.Exp(val) * (math.Sin(val) + math.Cos(val)),
+			tol:           1e-8,
+			expectedError: nil,
+		},
+		"forward second derivative": {
+			offsets:       numericalgo.Vector{0, 1, 2, 3, 4},
+			m:             2,
+			h:             0.01,
+			expectedValue: 2 * math.Exp(val) * math.Cos(val),
+			tol:           5e-5,
+			expectedError: nil,
+		},
+		"central third derivative": {
+			offsets:       numericalgo.Vector{-3, -2, -1, 0, 1, 2, 3},
+			m:             3,
+			h:             0.01,
+			expectedValue: 2 * math.Exp(val) * (math.Cos(val) - math.Sin(val)),
+			tol:           1e-5,
+			expectedError: nil,
+		},
+		"wrong step size": {
+			offsets:       numericalgo.Vector{-1, 0, 1},
+			m:             1,
+			h:             0,
+			expectedValue: 0,
+			tol:           0,
+			expectedError: fmt.Errorf("Step size has to be greater than 0"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := differentiate.Stencil(f, val, c.h, c.offsets, c.m)
+			assert.Equal(t, c.expectedError, err)
+			assert.InDelta(t, c.expectedValue, result, c.tol)
+		})
+	}
+}
+
+func TestNamedStencils(t *testing.T) {
+	f := func(x float64) float64 {
+		return math.Cos(math.Pow(x, 2) - 2)
+	}
+	// f'(1) = 2 sin(1), f''(1) = 2 sin(1) - 4 cos(1).
+	first := 2 * math.Sin(1)
+	second := 2*math.Sin(1) - 4*math.Cos(1)
+
+	cases := map[string]struct {
+		method        func(func(float64) float64, float64, float64) (float64, error)
+		h             float64
+		expectedValue float64
+		tol           float64
+	}{
+		"second derivative": {
+			method:        differentiate.Second,
+			h:             0.001,
+			expectedValue: second,
+			tol:           1e-5,
+		},
+		"fourth-order second derivative": {
+			method:        differentiate.Second4,
+			h:             0.01,
+			expectedValue: second,
+			tol:           1e-7,
+		},
+		"fourth-order central difference": {
+			method:        differentiate.Central4,
+			h:             0.01,
+			expectedValue: first,
+			tol:           1e-8,
+		},
+		"sixth-order central difference": {
+			method:        differentiate.Central6,
+			h:             0.01,
+			expectedValue: first,
+			tol:           1e-10,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.method(f, 1, c.h)
+			assert.Nil(t, err)
+			assert.InDelta(t, c.expectedValue, result, c.tol)
+
+			_, err = c.method(f, 1, -1)
+			assert.Equal(t, fmt.Errorf("Step size has to be greater than 0"), err)
+		})
+	}
+}
+
+func TestHigherOrderConverges(t *testing.T) {
+	f := math.Exp
+	err2 := math.Abs(mustCentral(differentiate.Central, f, 0.1) - 1)
+	err4 := math.Abs(mustCentral(differentiate.Central4, f, 0.1) - 1)
+	err6 := math.Abs(mustCentral(differentiate.Central6, f, 0.1) - 1)
+	assert.True(t, err4 < err2/100)
+	assert.True(t, err6 < err4/100)
+}
+
+func mustCentral(method func(func(float64) float64, float64, float64) (float64, error), f func(float64) float64, h float64) float64 {
+	d, _ := method(f, 0, h)
+	return d
+}
@@ -0,0 +1,115 @@
+package differentiate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// TotalVariation receives the uniformly sampled noisy signal y, the sample spacing dx, the regularization weight
+// alpha and the number of iterations. It returns the derivative u of the signal at every sample, and the error (if
+// there is any). The derivative minimizes
+//
+//	1/2 * ||c + integral(u) - y||^2 + alpha * sum(|u[i+1] - u[i]|)
+//
+// over u and the integration constant c (Chartrand's total-variation regularized differentiation). Unlike finite
+// differences, which amplify the noise, the result is piecewise smooth and may contain jumps; larger alpha gives a
+// smoother derivative. The problem is solved by lagged diffusivity: every iteration solves a linear system of size
+// n+1, so it is meant for signals of up to a few hundred samples.
+func TotalVariation(y numericalgo.Vector, dx, alpha float64, iter int) (numericalgo.Vector, error) {
+	n := y.Dim()
+	if n < 3 {
+		return nil, fmt.Errorf("At least 3 samples are required")
+	}
+	if dx <= 0 {
+		return nil, fmt.Errorf("Sample spacing has to be greater than 0")
+	}
+	if alpha <= 0 {
+		return nil, fmt.Errorf("Regularization weight has to be greater than 0")
+	}
+	if iter <= 0 {
+		return nil, fmt.Errorf("Number of iterations must be positive")
+	}
+
+	// a is the trapezoidal antiderivative operator, with the last column for the integration constant.
+	a := make(numericalgo.Matrix, n)
+	for i := range a {
+		a[i] = make(numericalgo.Vector, n+1)
+		for j := 0; j <= i; j++ {
+			a[i][j] = dx
+		}
+		a[i][0] = dx / 2
+		a[i][i] = dx / 2
+		if i == 0 {
+			a[i][0] = 0
+		}
+		a[i][n] = 1
+	}
+
+	ata := make(numericalgo.Matrix, n+1)
+	aty := make(numericalgo.Vector, n+1)
+	for i := range ata {
+		ata[i] = make(numericalgo.Vector, n+1)
+		for j := range ata[i] {
+			for k := range a {
+				ata[i][j] += a[k][i] * a[k][j]
+			}
+		}
+		for k := range a {
+			aty[i] += a[k][i] * y[k]
+		}
+	}
+
+	x := make(numericalgo.Vector, n)
+	for i := range x {
+		x[i] = float64(i) * dx
+	}
+	u, _, err := Samples(x, y)
+	if err != nil {
+		return nil, err
+	}
+
+	// eps smooths |.| at zero, relative to the typical size of the jumps of u. It is floored by the size of the
+	// derivative implied by the signal itself (and by 1 for an all-zero signal), since the jumps of a linear or
+	// constant signal vanish and would otherwise make the weights overflow.
+	var scale, ymax float64
+	for i := 1; i < n; i++ {
+		scale = math.Max(scale, math.Abs(u[i]-u[i-1]))
+	}
+	for _, v := range y {
+		ymax = math.Max(ymax, math.Abs(v))
+	}
+	floor := ymax / (float64(n) * dx)
+	if floor == 0 {
+		floor = 1
+	}
+	eps := math.Pow(1e-6*math.Max(scale, floor), 2)
+
+	for it := 0; it < iter; it++ {
+		h := make(numericalgo.Matrix, n+1)
+		for i := range h {
+			h[i] = make(numericalgo.Vector, n+1)
+			copy(h[i], ata[i])
+		}
+		for i := 0; i < n-1; i++ {
+			d := u[i+1] - u[i]
+			w := alpha / math.Sqrt(d*d+eps)
+			h[i][i] += w
+			h[i+1][i+1] += w
+			h[i][i+1] -= w
+			h[i+1][i] -= w
+		}
+
+		lu, err := h.LU()
+		if err != nil {
+			return nil, err
+		}
+		sol, err := lu.Solve(aty)
+		if err != nil {
+			return nil, err
+		}
+		u = sol[:n]
+	}
+	return u, nil
+}
@@ -0,0 +1,147 @@
+package differentiate_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/differentiate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTotalVariation(t *testing.T) {
+	// |x - 0.5| plus noise has the derivative sign(x - 0.5), which total-variation regularization recovers with a
+	// sharp jump while finite differences are swamped by the noise.
+	rnd := rand.New(rand.NewSource(3))
+	dx := 0.01
+	n := 100
+	x := make(numericalgo.Vector, n)
+	y := make(numericalgo.Vector, n)
+	for i := range x {
+		x[i] = float64(i) * dx
+		y[i] = math.Abs(x[i]-0.5) + 0.005*rnd.NormFloat64()
+	}
+	sign := func(x float64) float64 {
+		if x < 0.5 {
+			return -1
+		}
+		return 1
+	}
+
+	raw, _, err := differentiate.Samples(x, y)
+	assert.Nil(t, err)
+	u, err := differentiate.TotalVariation(y, dx, 0.005, 30)
+	assert.Nil(t, err)
+	assert.Equal(t, n, u.Dim())
+
+	rawErr, tvErr := rmsError(raw, x, sign), rmsError(u, x, sign)
+	assert.True(t, tvErr < rawErr/2)
+	assert.True(t, tvErr < 0.2)
+	assert.InDelta(t, -1, u[20], 0.1)
+	assert.InDelta(t, 1, u[80], 0.1)
+}
+
+func TestTotalVariationSmoothSignal(t *testing.T) {
+	// Without noise and with little regularization the derivative of a smooth signal is reproduced.
+	dx := 0.05
+	n := 60
+	y := make(numericalgo.Vector, n)
+	x := make(numericalgo.Vector, n)
+	for i := range y {
+		x[i] = float64(i) * dx
+		y[i] = math.Sin(x[i])
+	}
+
+	u, err := differentiate.TotalVariation(y, dx, 1e-6, 20)
+	assert.Nil(t, err)
+	assert.True(t, rmsError(u, x, math.Cos) < 0.01)
+}
+
+func TestTotalVariationExactSignals(t *testing.T) {
+	cases := map[string]struct {
+		f             func(float64) float64
+		expectedValue float64
+	}{
+		"linear ramp": {
+			f:             func(x float64) float64 { return 3 * x },
+			expectedValue: 3,
+		},
+		"constant": {
+			f:             func(x float64) float64 { return 2 },
+			expectedValue: 0,
+		},
+		"zero": {
+			f:             func(x float64) float64 { return 0 },
+			expectedValue: 0,
+		},
+	}
+
+	dx := 0.1
+	n := 40
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			y := make(numericalgo.Vector, n)
+			for i := range y {
+				y[i] = c.f(float64(i) * dx)
+			}
+
+			u, err := differentiate.TotalVariation(y, dx, 0.1, 20)
+			assert.Nil(t, err)
+			assert.Equal(t, n, u.Dim())
+			for _, v := range u {
+				assert.InDelta(t, c.expectedValue, v, 1e-6)
+			}
+		})
+	}
+}
+
+func TestTotalVariationErrors(t *testing.T) {
+	y := numericalgo.Vector{1, 2, 3, 4}
+
+	cases := map[string]struct {
+		y             numericalgo.Vector
+		dx            float64
+		alpha         float64
+		iter          int
+		expectedError error
+	}{
+		"too few samples": {
+			y:             numericalgo.Vector{1, 2},
+			dx:            1,
+			alpha:         1,
+			iter:          1,
+			expectedError: fmt.Errorf("At least 3 samples are required"),
+		},
+		"wrong spacing": {
+			y:             y,
+			dx:            0,
+			alpha:         1,
+			iter:          1,
+			expectedError: fmt.Errorf("Sample spacing has to be greater than 0"),
+		},
+		"wrong regularization weight": {
+			y:             y,
+			dx:            1,
+			alpha:         0,
+			iter:          1,
+			expectedError: fmt.Errorf("Regularization weight has to be greater than 0"),
+		},
+		"wrong number of iterations": {
+			y:             y,
+			dx:            1,
+			alpha:         1,
+			iter:          0,
+			expectedError: fmt.Errorf("Number of iterations must be positive"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			u, err := differentiate.TotalVariation(c.y, c.dx, c.alpha, c.iter)
+			assert.Equal(t, c.expectedError, err)
+			assert.Nil(t, u)
+		})
+	}
+}
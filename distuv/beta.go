@@ -0,0 +1,115 @@
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Beta is the beta distribution on [0, 1] with shape parameters alpha and beta, whose density is proportional to
+// x^(alpha-1) * (1-x)^(beta-1).
+type Beta struct {
+	alpha, beta float64
+	src         source
+}
+
+// NewBeta receives the two shape parameters and the source of random numbers (nil for the global source). It returns
+// the pointer to the new Beta distribution and the error (if there is any).
+func NewBeta(alpha, beta float64, src rand.Source) (*Beta, error) {
+	if !(alpha > 0) || !(beta > 0) || math.IsInf(alpha, 1) || math.IsInf(beta, 1) {
+		return nil, fmt.Errorf("Shape parameters must be positive")
+	}
+	return &Beta{alpha: alpha, beta: beta, src: newSource(src)}, nil
+}
+
+// PDF returns the probability density function at x.
+func (b *Beta) PDF(x float64) float64 {
+	return betaPDF(b.alpha, b.beta, x)
+}
+
+// CDF returns the cumulative distribution function P(X <= x).
+func (b *Beta) CDF(x float64) float64 {
+	return betaI(b.alpha, b.beta, x)
+}
+
+// Survival returns the survival function P(X > x) = 1 - CDF(x).
+func (b *Beta) Survival(x float64) float64 {
+	return betaI(b.beta, b.alpha, 1-x)
+}
+
+// Quantile returns the inverse of the cumulative distribution function, the value x for which CDF(x) = p. It
+// returns NaN if p is not in [0, 1].
+func (b *Beta) Quantile(p float64) float64 {
+	return betaQuantile(b.alpha, b.beta, p)
+}
+
+// Rand returns a random sample from the distribution.
+func (b *Beta) Rand() float64 {
+	return sampleBeta(b.src, b.alpha, b.beta)
+}
+
+// Mean returns the mean of the distribution.
+func (b *Beta) Mean() float64 {
+	return b.alpha / (b.alpha + b.beta)
+}
+
+// Variance returns the variance of the distribution.
+func (b *Beta) Variance() float64 {
+	s := b.alpha + b.beta
+	return b.alpha * b.beta / (s * s * (s + 1))
+}
+
+func betaPDF(a, b, x float64) float64 {
+	switch {
+	case x < 0 || x > 1:
+		return 0
+	case x == 0 && a < 1 || x == 1 && b < 1:
+		return math.Inf(1)
+	case x == 0:
+		if a == 1 {
+			return b
+		}
+		return 0
+	case x == 1:
+		if b == 1 {
+			return a
+		}
+		return 0
+	}
+	return math.Exp((a-1)*math.Log(x) + (b-1)*math.Log1p(-x) - lbeta(a, b))
+}
+
+// betaQuantile returns x such that I_x(a, b) = p.
+func betaQuantile(a, b, p float64) float64 {
+	switch {
+	case p < 0 || p > 1 || math.IsNaN(p):
+		return math.NaN()
+	case p == 0:
+		return 0
+	case p == 1:
+		return 1
+	}
+
+	// Solving in the lighter tail keeps the full relative precision of the result.
+	if p > 0.5 {
+		return 1 - betaQuantile(b, a, 1-p)
+	}
+
+	// The leading term of the series of I_x(a, b) around 0 gives the starting point.
+	x0 := math.Pow(p*a*math.Exp(lbeta(a, b)), 1/a)
+	if !(x0 > 0 && x0 < 1) {
+		x0 = a / (a + b)
+	}
+
+	return invert(func(x float64) float64 {
+		return betaI(a, b, x)
+	}, func(x float64) float64 {
+		return betaPDF(a, b, x)
+	}, p, x0, 0, 1)
+}
+
+func sampleBeta(s source, a, b float64) float64 {
+	x := sampleGamma(s, a)
+	y := sampleGamma(s, b)
+	return x / (x + y)
+}
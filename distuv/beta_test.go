@@ -0,0 +1,41 @@
+package distuv_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBeta(t *testing.T) {
+	d, err := distuv.NewBeta(2, 3, nil)
+	assert.Nil(t, err)
+
+	assert.InEpsilon(t, 1.5, d.PDF(0.5), 1e-14)
+	assert.InEpsilon(t, 0.6875, d.CDF(0.5), 1e-14)
+	assert.InEpsilon(t, 0.3125, d.Survival(0.5), 1e-14)
+	assert.InEpsilon(t, 0.5, d.Quantile(0.6875), 1e-12)
+	assert.Equal(t, 0.0, d.Quantile(0))
+	assert.Equal(t, 1.0, d.Quantile(1))
+}
+
+func TestBetaQuantileRoundTrip(t *testing.T) {
+	for _, ab := range [][2]float64{{0.5, 0.5}, {1, 1}, {2, 5}, {30, 0.7}} {
+		d, _ := distuv.NewBeta(ab[0], ab[1], nil)
+		for _, p := range []float64{1e-6, 0.1, 0.5, 0.9, 1 - 1e-6} {
+			assert.InEpsilon(t, p, d.CDF(d.Quantile(p)), 1e-9)
+		}
+	}
+}
+
+func TestBetaRand(t *testing.T) {
+	d, _ := distuv.NewBeta(2, 3, rand.NewSource(1))
+	checkSampling(t, d, 100000)
+}
+
+func TestNewBetaInvalid(t *testing.T) {
+	_, err := distuv.NewBeta(1, -1, nil)
+	assert.Equal(t, fmt.Errorf("Shape parameters must be positive"), err)
+}
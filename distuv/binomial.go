@@ -0,0 +1,125 @@
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Binomial is the binomial distribution, the distribution of the number of successes in n independent trials with
+// the success probability p.
+type Binomial struct {
+	n, p float64
+	src  source
+}
+
+// NewBinomial receives the number of trials, the success probability and the source of random numbers (nil for the
+// global source). It returns the pointer to the new Binomial distribution and the error (if there is any).
+func NewBinomial(n int, p float64, src rand.Source) (*Binomial, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("Number of trials cannot be negative")
+	}
+	if !(p >= 0 && p <= 1) {
+		return nil, fmt.Errorf("Probability must be between 0 and 1")
+	}
+	return &Binomial{n: float64(n), p: p, src: newSource(src)}, nil
+}
+
+// PMF returns the probability mass function P(X = k). It is zero unless k is an integer between 0 and n.
+func (b *Binomial) PMF(k float64) float64 {
+	if k < 0 || k > b.n || k != math.Floor(k) {
+		return 0
+	}
+
+	switch b.p {
+	case 0:
+		if k == 0 {
+			return 1
+		}
+		return 0
+	case 1:
+		if k == b.n {
+			return 1
+		}
+		return 0
+	}
+
+	ln, _ := math.Lgamma(b.n + 1)
+	lk, _ := math.Lgamma(k + 1)
+	lnk, _ := math.Lgamma(b.n - k + 1)
+	return math.Exp(ln - lk - lnk + k*math.Log(b.p) + (b.n-k)*math.Log1p(-b.p))
+}
+
+// CDF returns the cumulative distribution function P(X <= k).
+func (b *Binomial) CDF(k float64) float64 {
+	k = math.Floor(k)
+	switch {
+	case k < 0:
+		return 0
+	case k >= b.n:
+		return 1
+	case b.p == 0:
+		return 1
+	case b.p == 1:
+		return 0
+	}
+	return betaI(b.n-k, k+1, 1-b.p)
+}
+
+// Survival returns the survival function P(X > k) = 1 - CDF(k), computed without cancellation in the upper tail.
+func (b *Binomial) Survival(k float64) float64 {
+	k = math.Floor(k)
+	switch {
+	case k < 0:
+		return 1
+	case k >= b.n:
+		return 0
+	case b.p == 0:
+		return 0
+	case b.p == 1:
+		return 1
+	}
+	return betaI(k+1, b.n-k, b.p)
+}
+
+// Quantile returns the smallest integer k for which CDF(k) >= prob. It returns NaN if prob is not in [0, 1].
+func (b *Binomial) Quantile(prob float64) float64 {
+	if prob < 0 || prob > 1 || math.IsNaN(prob) {
+		return math.NaN()
+	}
+	return discreteQuantile(b.CDF, prob, b.n*b.p, math.Sqrt(b.n*b.p*(1-b.p)), b.n)
+}
+
+// Rand returns a random sample from the distribution. Large numbers of trials are reduced with the beta splitting
+// method of Knuth until counting Bernoulli trials is cheap.
+func (b *Binomial) Rand() float64 {
+	n, p := b.n, b.p
+	var k float64
+	for n > 64 {
+		a := math.Floor(1 + n/2)
+		x := sampleBeta(b.src, a, n+1-a)
+		if x >= p {
+			n, p = a-1, p/x
+		} else {
+			k += a
+			n, p = n-a, (p-x)/(1-x)
+		}
+	}
+
+	for i := 0.0; i < n; i++ {
+		if b.src.uniform() < p {
+			k++
+		}
+	}
+	return k
+}
+
+// Mean returns the mean of the distribution.
+func (b *Binomial) Mean() float64 {
+	return b.n * b.p
+}
+
+// Variance returns the variance of the distribution.
+func (b *Binomial) Variance() float64 {
+	return b.n * b.p * (1 - b.p)
+}
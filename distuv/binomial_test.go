@@ -0,0 +1,104 @@
+package distuv_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinomial(t *testing.T) {
+	d, err := distuv.NewBinomial(10, 0.3, nil)
+	assert.Nil(t, err)
+
+	assert.InEpsilon(t, 0.266827932, d.PMF(3), 1e-12)
+	assert.InEpsilon(t, 0.6496107184, d.CDF(3), 1e-12)
+	assert.InEpsilon(t, 1-0.6496107184, d.Survival(3), 1e-12)
+	assert.Equal(t, 1.0, d.CDF(10))
+	assert.Equal(t, 3.0, d.Quantile(0.5))
+	assert.Equal(t, 4.0, d.Quantile(0.65))
+	assert.Equal(t, 10.0, d.Quantile(1))
+}
+
+func TestBinomialQuantile(t *testing.T) {
+	cases := map[string]struct {
+		n             int
+		p             float64
+		prob          float64
+		expectedValue float64
+	}{
+		"zero probability": {
+			n:             10,
+			p:             0.3,
+			prob:          0,
+			expectedValue: 0,
+		},
+		"median": {
+			n:             10,
+			p:             0.3,
+			prob:          0.5,
+			expectedValue: 3,
+		},
+		"never succeeds at zero": {
+			n:             10,
+			p:             0,
+			prob:          0,
+			expectedValue: 0,
+		},
+		"never succeeds at one": {
+			n:             10,
+			p:             0,
+			prob:          1,
+			expectedValue: 0,
+		},
+		"always succeeds at zero": {
+			n:             10,
+			p:             1,
+			prob:          0,
+			expectedValue: 0,
+		},
+		"always succeeds at median": {
+			n:             10,
+			p:             1,
+			prob:          0.5,
+			expectedValue: 10,
+		},
+		"always succeeds at one": {
+			n:             10,
+			p:             1,
+			prob:          1,
+			expectedValue: 10,
+		},
+		"no trials": {
+			n:             0,
+			p:             0.5,
+			prob:          1,
+			expectedValue: 0,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, err := distuv.NewBinomial(c.n, c.p, nil)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, c.expectedValue, d.Quantile(c.prob))
+		})
+	}
+}
+
+func TestBinomialRand(t *testing.T) {
+	for _, n := range []int{20, 1000} {
+		d, _ := distuv.NewBinomial(n, 0.3, rand.NewSource(1))
+		checkSampling(t, d, 100000)
+	}
+}
+
+func TestNewBinomialInvalid(t *testing.T) {
+	_, err := distuv.NewBinomial(-1, 0.5, nil)
+	assert.Equal(t, fmt.Errorf("Number of trials cannot be negative"), err)
+
+	_, err = distuv.NewBinomial(10, 1.5, nil)
+	assert.Equal(t, fmt.Errorf("Probability must be between 0 and 1"), err)
+}
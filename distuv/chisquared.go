@@ -0,0 +1,59 @@
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// ChiSquared is the chi-squared distribution with k degrees of freedom, the distribution of the sum of squares of k
+// independent standard normal variables.
+type ChiSquared struct {
+	k   float64
+	src source
+}
+
+// NewChiSquared receives the degrees of freedom and the source of random numbers (nil for the global source). It
+// returns the pointer to the new ChiSquared distribution and the error (if there is any).
+func NewChiSquared(k float64, src rand.Source) (*ChiSquared, error) {
+	if !(k > 0) || math.IsInf(k, 1) {
+		return nil, fmt.Errorf("Degrees of freedom must be positive")
+	}
+	return &ChiSquared{k: k, src: newSource(src)}, nil
+}
+
+// PDF returns the probability density function at x.
+func (c *ChiSquared) PDF(x float64) float64 {
+	return gammaPDF(c.k/2, x/2) / 2
+}
+
+// CDF returns the cumulative distribution function P(X <= x).
+func (c *ChiSquared) CDF(x float64) float64 {
+	return gammaP(c.k/2, x/2)
+}
+
+// Survival returns the survival function P(X > x) = 1 - CDF(x), computed without cancellation in the upper tail.
+func (c *ChiSquared) Survival(x float64) float64 {
+	return gammaQ(c.k/2, x/2)
+}
+
+// Quantile returns the inverse of the cumulative distribution function, the value x for which CDF(x) = p. It
+// returns NaN if p is not in [0, 1].
+func (c *ChiSquared) Quantile(p float64) float64 {
+	return 2 * gammaQuantile(c.k/2, p)
+}
+
+// Rand returns a random sample from the distribution.
+func (c *ChiSquared) Rand() float64 {
+	return 2 * sampleGamma(c.src, c.k/2)
+}
+
+// Mean returns the mean of the distribution.
+func (c *ChiSquared) Mean() float64 {
+	return c.k
+}
+
+// Variance returns the variance of the distribution.
+func (c *ChiSquared) Variance() float64 {
+	return 2 * c.k
+}
@@ -0,0 +1,55 @@
+package distuv_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChiSquared(t *testing.T) {
+	cases := map[string]struct {
+		k        float64
+		p        float64
+		quantile float64
+	}{
+		"one degree of freedom": {
+			k:        1,
+			p:        0.95,
+			quantile: 3.841458820694124,
+		},
+		"two degrees of freedom": {
+			k:        2,
+			p:        0.95,
+			quantile: 5.991464547107979,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, err := distuv.NewChiSquared(c.k, nil)
+			assert.Nil(t, err)
+			assert.InEpsilon(t, c.quantile, d.Quantile(c.p), 1e-12)
+			assert.InEpsilon(t, c.p, d.CDF(c.quantile), 1e-12)
+			assert.InEpsilon(t, 1-c.p, d.Survival(c.quantile), 1e-12)
+		})
+	}
+}
+
+func TestChiSquaredPDF(t *testing.T) {
+	d, _ := distuv.NewChiSquared(2, nil)
+	assert.InEpsilon(t, 0.18393972058572117, d.PDF(2), 1e-14)
+	assert.Equal(t, 0.0, d.PDF(-1))
+}
+
+func TestChiSquaredRand(t *testing.T) {
+	d, _ := distuv.NewChiSquared(3, rand.NewSource(1))
+	checkSampling(t, d, 100000)
+}
+
+func TestNewChiSquaredInvalid(t *testing.T) {
+	_, err := distuv.NewChiSquared(-1, nil)
+	assert.Equal(t, fmt.Errorf("Degrees of freedom must be positive"), err)
+}
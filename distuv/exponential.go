@@ -0,0 +1,71 @@
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Exponential is the exponential distribution with the given rate, the distribution of the waiting time between
+// events of a Poisson process.
+type Exponential struct {
+	rate float64
+	src  source
+}
+
+// NewExponential receives the rate and the source of random numbers (nil for the global source). It returns the
+// pointer to the new Exponential distribution and the error (if there is any).
+func NewExponential(rate float64, src rand.Source) (*Exponential, error) {
+	if !(rate > 0) || math.IsInf(rate, 1) {
+		return nil, fmt.Errorf("Rate must be positive")
+	}
+	return &Exponential{rate: rate, src: newSource(src)}, nil
+}
+
+// PDF returns the probability density function at x.
+func (e *Exponential) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return e.rate * math.Exp(-e.rate*x)
+}
+
+// CDF returns the cumulative distribution function P(X <= x).
+func (e *Exponential) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return -math.Expm1(-e.rate * x)
+}
+
+// Survival returns the survival function P(X > x) = 1 - CDF(x).
+func (e *Exponential) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	return math.Exp(-e.rate * x)
+}
+
+// Quantile returns the inverse of the cumulative distribution function, the value x for which CDF(x) = p. It
+// returns NaN if p is not in [0, 1].
+func (e *Exponential) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		return math.NaN()
+	}
+	return -math.Log1p(-p) / e.rate
+}
+
+// Rand returns a random sample from the distribution.
+func (e *Exponential) Rand() float64 {
+	return e.src.exponential() / e.rate
+}
+
+// Mean returns the mean of the distribution.
+func (e *Exponential) Mean() float64 {
+	return 1 / e.rate
+}
+
+// Variance returns the variance of the distribution.
+func (e *Exponential) Variance() float64 {
+	return 1 / (e.rate * e.rate)
+}
@@ -0,0 +1,33 @@
+package distuv_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponential(t *testing.T) {
+	d, err := distuv.NewExponential(2, nil)
+	assert.Nil(t, err)
+
+	assert.InEpsilon(t, 2*math.Exp(-2), d.PDF(1), 1e-15)
+	assert.InEpsilon(t, 0.8646647167633873, d.CDF(1), 1e-15)
+	assert.InEpsilon(t, math.Exp(-2), d.Survival(1), 1e-15)
+	assert.InEpsilon(t, 1, d.Quantile(0.8646647167633873), 1e-14)
+	assert.InEpsilon(t, 5e-17, d.CDF(2.5e-17), 1e-15)
+	assert.Equal(t, 0.0, d.PDF(-1))
+}
+
+func TestExponentialRand(t *testing.T) {
+	d, _ := distuv.NewExponential(0.5, rand.NewSource(1))
+	checkSampling(t, d, 100000)
+}
+
+func TestNewExponentialInvalid(t *testing.T) {
+	_, err := distuv.NewExponential(0, nil)
+	assert.Equal(t, fmt.Errorf("Rate must be positive"), err)
+}
@@ -0,0 +1,83 @@
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// F is the F (Fisher-Snedecor) distribution with d1 and d2 degrees of freedom, the distribution of the ratio of two
+// independent chi-squared variables divided by their degrees of freedom.
+type F struct {
+	d1, d2 float64
+	src    source
+}
+
+// NewF receives the numerator and denominator degrees of freedom and the source of random numbers (nil for the
+// global source). It returns the pointer to the new F distribution and the error (if there is any).
+func NewF(d1, d2 float64, src rand.Source) (*F, error) {
+	if !(d1 > 0) || !(d2 > 0) || math.IsInf(d1, 1) || math.IsInf(d2, 1) {
+		return nil, fmt.Errorf("Degrees of freedom must be positive")
+	}
+	return &F{d1: d1, d2: d2, src: newSource(src)}, nil
+}
+
+// PDF returns the probability density function at x.
+func (f *F) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	// Density of X follows from the beta density of Z = d1*X / (d1*X + d2).
+	z := f.d1 * x / (f.d1*x + f.d2)
+	dz := f.d1 * f.d2 / ((f.d1*x + f.d2) * (f.d1*x + f.d2))
+	return betaPDF(f.d1/2, f.d2/2, z) * dz
+}
+
+// CDF returns the cumulative distribution function P(X <= x).
+func (f *F) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return betaI(f.d1/2, f.d2/2, f.d1*x/(f.d1*x+f.d2))
+}
+
+// Survival returns the survival function P(X > x) = 1 - CDF(x), computed without cancellation in the upper tail.
+func (f *F) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return betaI(f.d2/2, f.d1/2, f.d2/(f.d1*x+f.d2))
+}
+
+// Quantile returns the inverse of the cumulative distribution function, the value x for which CDF(x) = p. It
+// returns NaN if p is not in [0, 1].
+func (f *F) Quantile(p float64) float64 {
+	z := betaQuantile(f.d1/2, f.d2/2, p)
+	if z == 1 {
+		return math.Inf(1)
+	}
+	return f.d2 * z / (f.d1 * (1 - z))
+}
+
+// Rand returns a random sample from the distribution.
+func (f *F) Rand() float64 {
+	x := sampleGamma(f.src, f.d1/2) / f.d1
+	y := sampleGamma(f.src, f.d2/2) / f.d2
+	return x / y
+}
+
+// Mean returns the mean of the distribution, which is undefined (NaN) for d2 <= 2.
+func (f *F) Mean() float64 {
+	if f.d2 <= 2 {
+		return math.NaN()
+	}
+	return f.d2 / (f.d2 - 2)
+}
+
+// Variance returns the variance of the distribution, which is undefined (NaN) for d2 <= 4.
+func (f *F) Variance() float64 {
+	if f.d2 <= 4 {
+		return math.NaN()
+	}
+	return 2 * f.d2 * f.d2 * (f.d1 + f.d2 - 2) / (f.d1 * (f.d2 - 2) * (f.d2 - 2) * (f.d2 - 4))
+}
@@ -0,0 +1,32 @@
+package distuv_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestF(t *testing.T) {
+	d, err := distuv.NewF(2, 4, nil)
+	assert.Nil(t, err)
+
+	// For d1 = 2 the CDF has the closed form 1 - (1 + 2x/d2)^(-d2/2).
+	assert.InEpsilon(t, 0.5555555555555556, d.CDF(1), 1e-14)
+	assert.InEpsilon(t, 0.4444444444444444, d.Survival(1), 1e-14)
+	assert.InEpsilon(t, 0.2962962962962963, d.PDF(1), 1e-14)
+	assert.InEpsilon(t, 6.944271909999159, d.Quantile(0.95), 1e-12)
+	assert.Equal(t, 0.0, d.CDF(-1))
+}
+
+func TestFRand(t *testing.T) {
+	d, _ := distuv.NewF(5, 20, rand.NewSource(1))
+	checkSampling(t, d, 200000)
+}
+
+func TestNewFInvalid(t *testing.T) {
+	_, err := distuv.NewF(1, 0, nil)
+	assert.Equal(t, fmt.Errorf("Degrees of freedom must be positive"), err)
+}
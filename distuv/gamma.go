@@ -0,0 +1,125 @@
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Gamma is the gamma distribution with shape k and scale theta, whose density is proportional to
+// x^(k-1) * e^(-x/theta) for x > 0.
+type Gamma struct {
+	shape, scale float64
+	src          source
+}
+
+// NewGamma receives the shape, the scale and the source of random numbers (nil for the global source). It returns
+// the pointer to the new Gamma distribution and the error (if there is any).
+func NewGamma(shape, scale float64, src rand.Source) (*Gamma, error) {
+	if !(shape > 0) || !(scale > 0) || math.IsInf(shape, 1) || math.IsInf(scale, 1) {
+		return nil, fmt.Errorf("Shape and scale must be positive")
+	}
+	return &Gamma{shape: shape, scale: scale, src: newSource(src)}, nil
+}
+
+// PDF returns the probability density function at x.
+func (g *Gamma) PDF(x float64) float64 {
+	return gammaPDF(g.shape, x/g.scale) / g.scale
+}
+
+// CDF returns the cumulative distribution function P(X <= x).
+func (g *Gamma) CDF(x float64) float64 {
+	return gammaP(g.shape, x/g.scale)
+}
+
+// Survival returns the survival function P(X > x) = 1 - CDF(x), computed without cancellation in the upper tail.
+func (g *Gamma) Survival(x float64) float64 {
+	return gammaQ(g.shape, x/g.scale)
+}
+
+// Quantile returns the inverse of the cumulative distribution function, the value x for which CDF(x) = p. It
+// returns NaN if p is not in [0, 1].
+func (g *Gamma) Quantile(p float64) float64 {
+	return g.scale * gammaQuantile(g.shape, p)
+}
+
+// Rand returns a random sample from the distribution.
+func (g *Gamma) Rand() float64 {
+	return g.scale * sampleGamma(g.src, g.shape)
+}
+
+// Mean returns the mean of the distribution.
+func (g *Gamma) Mean() float64 {
+	return g.shape * g.scale
+}
+
+// Variance returns the variance of the distribution.
+func (g *Gamma) Variance() float64 {
+	return g.shape * g.scale * g.scale
+}
+
+// gammaPDF returns the density of the gamma distribution with the given shape and unit scale.
+func gammaPDF(k, x float64) float64 {
+	switch {
+	case x < 0:
+		return 0
+	case x == 0:
+		if k < 1 {
+			return math.Inf(1)
+		} else if k == 1 {
+			return 1
+		}
+		return 0
+	}
+	lg, _ := math.Lgamma(k)
+	return math.Exp((k-1)*math.Log(x) - x - lg)
+}
+
+// gammaQuantile returns the quantile of the gamma distribution with the given shape and unit scale.
+func gammaQuantile(k, p float64) float64 {
+	switch {
+	case p < 0 || p > 1 || math.IsNaN(p):
+		return math.NaN()
+	case p == 0:
+		return 0
+	case p == 1:
+		return math.Inf(1)
+	}
+
+	// Wilson-Hilferty approximation as the starting point.
+	z := normalQuantile(p)
+	x0 := k * math.Pow(1-1/(9*k)+z/(3*math.Sqrt(k)), 3)
+	if !(x0 > 0) {
+		x0 = math.Pow(p*k*math.Exp(func() float64 { lg, _ := math.Lgamma(k); return lg }()), 1/k)
+	}
+
+	return invert(func(x float64) float64 {
+		return gammaP(k, x)
+	}, func(x float64) float64 {
+		return gammaPDF(k, x)
+	}, p, x0, 0, math.Inf(1))
+}
+
+// sampleGamma returns a sample of the gamma distribution with the given shape and unit scale, generated with the
+// method of Marsaglia and Tsang.
+func sampleGamma(s source, k float64) float64 {
+	if k < 1 {
+		// Boost the shape above 1 and correct with a power of a uniform sample.
+		return sampleGamma(s, k+1) * math.Pow(s.uniform(), 1/k)
+	}
+
+	d := k - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		var x, v float64
+		for v <= 0 {
+			x = s.normal()
+			v = 1 + c*x
+		}
+		v = v * v * v
+		u := s.uniform()
+		if u < 1-0.0331*x*x*x*x || math.Log(u) < x*x/2+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
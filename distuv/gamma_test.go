@@ -0,0 +1,46 @@
+package distuv_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGamma(t *testing.T) {
+	d, err := distuv.NewGamma(3, 1, nil)
+	assert.Nil(t, err)
+
+	assert.InEpsilon(t, 0.3233235838169366, d.CDF(2), 1e-14)
+	assert.InEpsilon(t, 1-0.3233235838169366, d.Survival(2), 1e-14)
+	assert.InEpsilon(t, 2*math.Exp(-2), d.PDF(2), 1e-14)
+	assert.InEpsilon(t, 2, d.Quantile(0.3233235838169366), 1e-12)
+
+	d, _ = distuv.NewGamma(3, 2, nil)
+	assert.InEpsilon(t, 0.3233235838169366, d.CDF(4), 1e-14)
+	assert.InEpsilon(t, 4, d.Quantile(0.3233235838169366), 1e-12)
+}
+
+func TestGammaQuantileRoundTrip(t *testing.T) {
+	for _, shape := range []float64{0.1, 0.5, 1, 2.5, 50} {
+		d, _ := distuv.NewGamma(shape, 1, nil)
+		for _, p := range []float64{1e-8, 0.05, 0.5, 0.95, 1 - 1e-8} {
+			assert.InEpsilon(t, p, d.CDF(d.Quantile(p)), 1e-9)
+		}
+	}
+}
+
+func TestGammaRand(t *testing.T) {
+	for _, shape := range []float64{0.3, 1, 4.5} {
+		d, _ := distuv.NewGamma(shape, 2, rand.NewSource(1))
+		checkSampling(t, d, 200000)
+	}
+}
+
+func TestNewGammaInvalid(t *testing.T) {
+	_, err := distuv.NewGamma(0, 1, nil)
+	assert.Equal(t, fmt.Errorf("Shape and scale must be positive"), err)
+}
@@ -0,0 +1,59 @@
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Normal is the normal (Gaussian) distribution with mean Mu and standard deviation Sigma.
+type Normal struct {
+	mu, sigma float64
+	src       source
+}
+
+// NewNormal receives the mean, the standard deviation and the source of random numbers (nil for the global source).
+// It returns the pointer to the new Normal distribution and the error (if there is any).
+func NewNormal(mu, sigma float64, src rand.Source) (*Normal, error) {
+	if !(sigma > 0) || math.IsInf(sigma, 1) || math.IsNaN(mu) || math.IsInf(mu, 0) {
+		return nil, fmt.Errorf("Standard deviation must be positive and mean must be finite")
+	}
+	return &Normal{mu: mu, sigma: sigma, src: newSource(src)}, nil
+}
+
+// PDF returns the probability density function at x.
+func (n *Normal) PDF(x float64) float64 {
+	z := (x - n.mu) / n.sigma
+	return math.Exp(-z*z/2) / (n.sigma * math.Sqrt(2*math.Pi))
+}
+
+// CDF returns the cumulative distribution function P(X <= x).
+func (n *Normal) CDF(x float64) float64 {
+	return math.Erfc(-(x-n.mu)/(n.sigma*math.Sqrt2)) / 2
+}
+
+// Survival returns the survival function P(X > x) = 1 - CDF(x), computed without cancellation in the upper tail.
+func (n *Normal) Survival(x float64) float64 {
+	return math.Erfc((x-n.mu)/(n.sigma*math.Sqrt2)) / 2
+}
+
+// Quantile returns the inverse of the cumulative distribution function, the value x for which CDF(x) = p. It
+// returns NaN if p is not in [0, 1] and stays accurate far into the lower tail.
+func (n *Normal) Quantile(p float64) float64 {
+	return n.mu + n.sigma*normalQuantile(p)
+}
+
+// Rand returns a random sample from the distribution.
+func (n *Normal) Rand() float64 {
+	return n.mu + n.sigma*n.src.normal()
+}
+
+// Mean returns the mean of the distribution.
+func (n *Normal) Mean() float64 {
+	return n.mu
+}
+
+// Variance returns the variance of the distribution.
+func (n *Normal) Variance() float64 {
+	return n.sigma * n.sigma
+}
@@ -0,0 +1,114 @@
+package distuv_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/stretchr/testify/assert"
+)
+
+type sampler interface {
+	Rand() float64
+	Mean() float64
+	Variance() float64
+}
+
+// checkSampling compares the sample mean and variance of n draws against the moments of the distribution.
+func checkSampling(t *testing.T, d sampler, n int) {
+	var sum, sumSq float64
+	for i := 0; i < n; i++ {
+		x := d.Rand()
+		sum += x
+		sumSq += x * x
+	}
+	mean := sum / float64(n)
+	variance := sumSq/float64(n) - mean*mean
+
+	sd := math.Sqrt(d.Variance())
+	assert.InDelta(t, d.Mean(), mean, 5*sd/math.Sqrt(float64(n)))
+	assert.InEpsilon(t, d.Variance(), variance, 0.05)
+}
+
+func TestNormal(t *testing.T) {
+	n, err := distuv.NewNormal(0, 1, nil)
+	assert.Nil(t, err)
+
+	assert.InEpsilon(t, 0.3989422804014327, n.PDF(0), 1e-15)
+	assert.InEpsilon(t, 0.9750021048517795, n.CDF(1.96), 1e-15)
+	assert.InEpsilon(t, 0.024997895148220435, n.Survival(1.96), 1e-14)
+	assert.InEpsilon(t, 1.959963984540054, n.Quantile(0.975), 1e-14)
+	assert.Equal(t, 0.0, n.Quantile(0.5))
+	assert.True(t, math.IsInf(n.Quantile(1), 1))
+	assert.True(t, math.IsNaN(n.Quantile(1.5)))
+
+	n, err = distuv.NewNormal(10, 2, nil)
+	assert.Nil(t, err)
+	assert.InEpsilon(t, 0.9750021048517795, n.CDF(13.92), 1e-15)
+	assert.InEpsilon(t, 13.919927969080108, n.Quantile(0.975), 1e-14)
+}
+
+func TestNormalQuantileTails(t *testing.T) {
+	n, _ := distuv.NewNormal(0, 1, nil)
+
+	cases := map[string]struct {
+		p             float64
+		expectedValue float64
+	}{
+		"p = 1e-10": {
+			p:             1e-10,
+			expectedValue: -6.361340902404056,
+		},
+		"p = 1e-15": {
+			p:             1e-15,
+			expectedValue: -7.941345326170995,
+		},
+		"p = 1e-20": {
+			p:             1e-20,
+			expectedValue: -9.262340089798405,
+		},
+		"p = 1e-300": {
+			p:             1e-300,
+			expectedValue: -37.0470962993612,
+		},
+		"upper tail": {
+			p:             1 - 1e-10,
+			expectedValue: 6.361340889697421,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			x := n.Quantile(c.p)
+			assert.InEpsilon(t, c.expectedValue, x, 1e-14)
+			if c.p < 0.5 {
+				assert.InEpsilon(t, c.p, n.CDF(x), 1e-12)
+			}
+		})
+	}
+
+	assert.True(t, math.IsInf(n.Quantile(0), -1))
+	assert.True(t, math.IsNaN(n.Quantile(math.NaN())))
+	assert.True(t, math.IsNaN(n.Quantile(-0.1)))
+}
+
+func TestNormalRand(t *testing.T) {
+	n, _ := distuv.NewNormal(3, 2, rand.NewSource(1))
+	checkSampling(t, n, 100000)
+
+	a, _ := distuv.NewNormal(0, 1, rand.NewSource(42))
+	b, _ := distuv.NewNormal(0, 1, rand.NewSource(42))
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, a.Rand(), b.Rand())
+	}
+}
+
+func TestNewNormalInvalid(t *testing.T) {
+	_, err := distuv.NewNormal(0, 0, nil)
+	assert.Equal(t, fmt.Errorf("Standard deviation must be positive and mean must be finite"), err)
+
+	_, err = distuv.NewNormal(math.NaN(), 1, nil)
+	assert.Equal(t, fmt.Errorf("Standard deviation must be positive and mean must be finite"), err)
+}
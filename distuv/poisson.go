@@ -0,0 +1,125 @@
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Poisson is the Poisson distribution with mean lambda, the distribution of the number of events of a Poisson
+// process in a unit interval.
+type Poisson struct {
+	lambda float64
+	src    source
+}
+
+// NewPoisson receives the mean and the source of random numbers (nil for the global source). It returns the pointer
+// to the new Poisson distribution and the error (if there is any).
+func NewPoisson(lambda float64, src rand.Source) (*Poisson, error) {
+	if !(lambda > 0) || math.IsInf(lambda, 1) {
+		return nil, fmt.Errorf("Mean must be positive")
+	}
+	return &Poisson{lambda: lambda, src: newSource(src)}, nil
+}
+
+// PMF returns the probability mass function P(X = k). It is zero unless k is a non-negative integer.
+func (p *Poisson) PMF(k float64) float64 {
+	if k < 0 || k != math.Floor(k) {
+		return 0
+	}
+	lg, _ := math.Lgamma(k + 1)
+	return math.Exp(k*math.Log(p.lambda) - p.lambda - lg)
+}
+
+// CDF returns the cumulative distribution function P(X <= k).
+func (p *Poisson) CDF(k float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return gammaQ(math.Floor(k)+1, p.lambda)
+}
+
+// Survival returns the survival function P(X > k) = 1 - CDF(k), computed without cancellation in the upper tail.
+func (p *Poisson) Survival(k float64) float64 {
+	if k < 0 {
+		return 1
+	}
+	return gammaP(math.Floor(k)+1, p.lambda)
+}
+
+// Quantile returns the smallest integer k for which CDF(k) >= prob. It returns NaN if prob is not in [0, 1].
+func (p *Poisson) Quantile(prob float64) float64 {
+	if prob < 0 || prob > 1 || math.IsNaN(prob) {
+		return math.NaN()
+	} else if prob == 1 {
+		return math.Inf(1)
+	}
+	return discreteQuantile(p.CDF, prob, p.lambda, math.Sqrt(p.lambda), math.Inf(1))
+}
+
+// Rand returns a random sample from the distribution. Small means are sampled by multiplying uniform numbers, and
+// means of 10 or more with the transformed rejection method PTRS of Hörmann.
+func (p *Poisson) Rand() float64 {
+	if p.lambda < 10 {
+		limit := math.Exp(-p.lambda)
+		k := 0.0
+		prod := p.src.uniform()
+		for prod > limit {
+			k++
+			prod *= p.src.uniform()
+		}
+		return k
+	}
+
+	slam := math.Sqrt(p.lambda)
+	loglam := math.Log(p.lambda)
+	b := 0.931 + 2.53*slam
+	a := -0.059 + 0.02483*b
+	invalpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+
+	for {
+		u := p.src.uniform() - 0.5
+		v := p.src.uniform()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + p.lambda + 0.43)
+		if us >= 0.07 && v <= vr {
+			return k
+		}
+		if k < 0 || us < 0.013 && v > us {
+			continue
+		}
+		lg, _ := math.Lgamma(k + 1)
+		if math.Log(v)+math.Log(invalpha)-math.Log(a/(us*us)+b) <= -p.lambda+k*loglam-lg {
+			return k
+		}
+	}
+}
+
+// Mean returns the mean of the distribution.
+func (p *Poisson) Mean() float64 {
+	return p.lambda
+}
+
+// Variance returns the variance of the distribution.
+func (p *Poisson) Variance() float64 {
+	return p.lambda
+}
+
+// discreteQuantile returns the smallest integer k in [0, max] with cdf(k) >= p, searching from the normal
+// approximation with the given mean and standard deviation, or from the mean if the distribution is degenerate.
+func discreteQuantile(cdf func(float64) float64, p, mean, sd, max float64) float64 {
+	k := math.Floor(mean)
+	if sd > 0 {
+		k = math.Floor(mean + sd*normalQuantile(p))
+	}
+	k = math.Max(0, math.Min(max, k))
+
+	for k > 0 && cdf(k-1) >= p {
+		k--
+	}
+	for k < max && cdf(k) < p {
+		k++
+	}
+	return k
+}
@@ -0,0 +1,36 @@
+package distuv_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoisson(t *testing.T) {
+	d, err := distuv.NewPoisson(3, nil)
+	assert.Nil(t, err)
+
+	assert.InEpsilon(t, 0.22404180765538775, d.PMF(2), 1e-14)
+	assert.Equal(t, 0.0, d.PMF(2.5))
+	assert.InEpsilon(t, 0.42319008112684353, d.CDF(2), 1e-14)
+	assert.InEpsilon(t, 0.42319008112684353, d.CDF(2.7), 1e-14)
+	assert.InEpsilon(t, 1-0.42319008112684353, d.Survival(2), 1e-14)
+	assert.Equal(t, 3.0, d.Quantile(0.5))
+	assert.Equal(t, 2.0, d.Quantile(0.42319008112684353))
+	assert.Equal(t, 0.0, d.Quantile(0))
+}
+
+func TestPoissonRand(t *testing.T) {
+	for _, lambda := range []float64{2.5, 40} {
+		d, _ := distuv.NewPoisson(lambda, rand.NewSource(1))
+		checkSampling(t, d, 100000)
+	}
+}
+
+func TestNewPoissonInvalid(t *testing.T) {
+	_, err := distuv.NewPoisson(0, nil)
+	assert.Equal(t, fmt.Errorf("Mean must be positive"), err)
+}
@@ -0,0 +1,45 @@
+package distuv
+
+import "math/rand"
+
+// source provides the uniform, normal and exponential random numbers the distributions are sampled from. A nil
+// generator falls back to the global generator of math/rand.
+type source struct {
+	rnd *rand.Rand
+}
+
+func newSource(src rand.Source) source {
+	if src == nil {
+		return source{}
+	}
+	return source{rnd: rand.New(src)}
+}
+
+// uniform returns a random number from the open interval (0, 1).
+func (s source) uniform() float64 {
+	for {
+		var u float64
+		if s.rnd == nil {
+			u = rand.Float64()
+		} else {
+			u = s.rnd.Float64()
+		}
+		if u != 0 {
+			return u
+		}
+	}
+}
+
+func (s source) normal() float64 {
+	if s.rnd == nil {
+		return rand.NormFloat64()
+	}
+	return s.rnd.NormFloat64()
+}
+
+func (s source) exponential() float64 {
+	if s.rnd == nil {
+		return rand.ExpFloat64()
+	}
+	return s.rnd.ExpFloat64()
+}
@@ -0,0 +1,101 @@
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// StudentsT is Student's t distribution with nu degrees of freedom, the distribution of a standardized mean
+// estimated from a normal sample with unknown variance.
+type StudentsT struct {
+	nu  float64
+	src source
+}
+
+// NewStudentsT receives the degrees of freedom and the source of random numbers (nil for the global source). It
+// returns the pointer to the new StudentsT distribution and the error (if there is any).
+func NewStudentsT(nu float64, src rand.Source) (*StudentsT, error) {
+	if !(nu > 0) {
+		return nil, fmt.Errorf("Degrees of freedom must be positive")
+	}
+	return &StudentsT{nu: nu, src: newSource(src)}, nil
+}
+
+// PDF returns the probability density function at x.
+func (t *StudentsT) PDF(x float64) float64 {
+	return math.Exp(-(t.nu+1)/2*math.Log1p(x*x/t.nu)-lbeta(0.5, t.nu/2)) / math.Sqrt(t.nu)
+}
+
+// CDF returns the cumulative distribution function P(X <= x).
+func (t *StudentsT) CDF(x float64) float64 {
+	if x > 0 {
+		return 1 - t.tail(x)
+	}
+	return t.tail(-x)
+}
+
+// Survival returns the survival function P(X > x) = 1 - CDF(x), computed without cancellation in the upper tail.
+func (t *StudentsT) Survival(x float64) float64 {
+	return t.CDF(-x)
+}
+
+// tail returns P(X > x) for x >= 0.
+func (t *StudentsT) tail(x float64) float64 {
+	if math.IsInf(x, 1) {
+		return 0
+	}
+	return betaI(t.nu/2, 0.5, t.nu/(t.nu+x*x)) / 2
+}
+
+// Quantile returns the inverse of the cumulative distribution function, the value x for which CDF(x) = p. It
+// returns NaN if p is not in [0, 1].
+func (t *StudentsT) Quantile(p float64) float64 {
+	switch {
+	case p < 0 || p > 1 || math.IsNaN(p):
+		return math.NaN()
+	case p == 0:
+		return math.Inf(-1)
+	case p == 1:
+		return math.Inf(1)
+	case p > 0.5:
+		return -t.Quantile(1 - p)
+	case p == 0.5:
+		return 0
+	}
+
+	// P(X < -x) = I_z(nu/2, 1/2) / 2 with z = nu/(nu+x^2). Near the center z is close to 1, so the complement
+	// 1-z is solved for instead to keep the precision of x.
+	q := 2 * p
+	if q < 0.5 {
+		z := betaQuantile(t.nu/2, 0.5, q)
+		return -math.Sqrt(t.nu * (1 - z) / z)
+	}
+	y := betaQuantile(0.5, t.nu/2, 1-q)
+	return -math.Sqrt(t.nu * y / (1 - y))
+}
+
+// Rand returns a random sample from the distribution.
+func (t *StudentsT) Rand() float64 {
+	return t.src.normal() / math.Sqrt(2*sampleGamma(t.src, t.nu/2)/t.nu)
+}
+
+// Mean returns the mean of the distribution, which is undefined (NaN) for nu <= 1.
+func (t *StudentsT) Mean() float64 {
+	if t.nu <= 1 {
+		return math.NaN()
+	}
+	return 0
+}
+
+// Variance returns the variance of the distribution, which is infinite for 1 < nu <= 2 and undefined (NaN) for
+// nu <= 1.
+func (t *StudentsT) Variance() float64 {
+	switch {
+	case t.nu <= 1:
+		return math.NaN()
+	case t.nu <= 2:
+		return math.Inf(1)
+	}
+	return t.nu / (t.nu - 2)
+}
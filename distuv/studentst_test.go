@@ -0,0 +1,79 @@
+package distuv_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStudentsT(t *testing.T) {
+	cases := map[string]struct {
+		nu       float64
+		x        float64
+		cdf      float64
+		p        float64
+		quantile float64
+	}{
+		"cauchy": {
+			nu:       1,
+			x:        1,
+			cdf:      0.75,
+			p:        0.75,
+			quantile: 1,
+		},
+		"two degrees of freedom": {
+			nu:       2,
+			x:        1,
+			cdf:      0.7886751345948129,
+			p:        0.975,
+			quantile: 4.302652729749464,
+		},
+		"ten degrees of freedom": {
+			nu:       10,
+			x:        -2.228138851986274,
+			cdf:      0.025,
+			p:        0.975,
+			quantile: 2.228138851986274,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, err := distuv.NewStudentsT(c.nu, nil)
+			assert.Nil(t, err)
+			assert.InEpsilon(t, c.cdf, d.CDF(c.x), 1e-12)
+			assert.InEpsilon(t, 1-c.cdf, d.Survival(c.x), 1e-12)
+			assert.InEpsilon(t, c.quantile, d.Quantile(c.p), 1e-12)
+			assert.InEpsilon(t, -c.quantile, d.Quantile(1-c.p), 1e-12)
+		})
+	}
+}
+
+func TestStudentsTPDF(t *testing.T) {
+	d, _ := distuv.NewStudentsT(1, nil)
+	assert.InEpsilon(t, 1/math.Pi, d.PDF(0), 1e-14)
+	assert.InEpsilon(t, 1/(2*math.Pi), d.PDF(1), 1e-14)
+	assert.Equal(t, 0.0, d.Quantile(0.5))
+	assert.True(t, math.IsNaN(d.Mean()))
+}
+
+func TestStudentsTQuantileCenter(t *testing.T) {
+	d, _ := distuv.NewStudentsT(5, nil)
+	for _, p := range []float64{1e-10, 0.01, 0.3, 0.4999, 0.5001, 0.9} {
+		assert.InEpsilon(t, p, d.CDF(d.Quantile(p)), 1e-10)
+	}
+}
+
+func TestStudentsTRand(t *testing.T) {
+	d, _ := distuv.NewStudentsT(10, rand.NewSource(1))
+	checkSampling(t, d, 200000)
+}
+
+func TestNewStudentsTInvalid(t *testing.T) {
+	_, err := distuv.NewStudentsT(0, nil)
+	assert.Equal(t, fmt.Errorf("Degrees of freedom must be positive"), err)
+}
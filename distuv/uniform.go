@@ -0,0 +1,76 @@
+package distuv
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Uniform is the continuous uniform distribution on the interval [min, max].
+type Uniform struct {
+	min, max float64
+	src      source
+}
+
+// NewUniform receives the bounds of the interval and the source of random numbers (nil for the global source). It
+// returns the pointer to the new Uniform distribution and the error (if there is any).
+func NewUniform(min, max float64, src rand.Source) (*Uniform, error) {
+	if !(min < max) || math.IsInf(min, 0) || math.IsInf(max, 0) {
+		return nil, fmt.Errorf("Bounds must be finite and min must be less than max")
+	}
+	return &Uniform{min: min, max: max, src: newSource(src)}, nil
+}
+
+// PDF returns the probability density function at x.
+func (u *Uniform) PDF(x float64) float64 {
+	if x < u.min || x > u.max {
+		return 0
+	}
+	return 1 / (u.max - u.min)
+}
+
+// CDF returns the cumulative distribution function P(X <= x).
+func (u *Uniform) CDF(x float64) float64 {
+	switch {
+	case x <= u.min:
+		return 0
+	case x >= u.max:
+		return 1
+	}
+	return (x - u.min) / (u.max - u.min)
+}
+
+// Survival returns the survival function P(X > x) = 1 - CDF(x).
+func (u *Uniform) Survival(x float64) float64 {
+	switch {
+	case x <= u.min:
+		return 1
+	case x >= u.max:
+		return 0
+	}
+	return (u.max - x) / (u.max - u.min)
+}
+
+// Quantile returns the inverse of the cumulative distribution function, the value x for which CDF(x) = p. It
+// returns NaN if p is not in [0, 1].
+func (u *Uniform) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		return math.NaN()
+	}
+	return u.min + p*(u.max-u.min)
+}
+
+// Rand returns a random sample from the distribution.
+func (u *Uniform) Rand() float64 {
+	return u.min + u.src.uniform()*(u.max-u.min)
+}
+
+// Mean returns the mean of the distribution.
+func (u *Uniform) Mean() float64 {
+	return (u.min + u.max) / 2
+}
+
+// Variance returns the variance of the distribution.
+func (u *Uniform) Variance() float64 {
+	return (u.max - u.min) * (u.max - u.min) / 12
+}
@@ -0,0 +1,36 @@
+package distuv_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniform(t *testing.T) {
+	d, err := distuv.NewUniform(1, 3, nil)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 0.5, d.PDF(2))
+	assert.Equal(t, 0.0, d.PDF(4))
+	assert.Equal(t, 0.25, d.CDF(1.5))
+	assert.Equal(t, 0.75, d.Survival(1.5))
+	assert.Equal(t, 1.0, d.CDF(5))
+	assert.Equal(t, 2.5, d.Quantile(0.75))
+}
+
+func TestUniformRand(t *testing.T) {
+	d, _ := distuv.NewUniform(-1, 3, rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		x := d.Rand()
+		assert.True(t, x > -1 && x < 3)
+	}
+	checkSampling(t, d, 100000)
+}
+
+func TestNewUniformInvalid(t *testing.T) {
+	_, err := distuv.NewUniform(2, 2, nil)
+	assert.Equal(t, fmt.Errorf("Bounds must be finite and min must be less than max"), err)
+}
@@ -0,0 +1,49 @@
+package numericalgo
+
+import "fmt"
+
+// GramSchmidt receives a set of vectors and a tolerance. It orthonormalizes the vectors with the modified
+// Gram-Schmidt process, repeating the orthogonalization a second time to recover the orthogonality lost to
+// rounding. A vector whose norm drops below tol times its original norm after orthogonalization is linearly dependent
+// on the previous ones and is left out. It returns the orthonormal basis of the span of the vectors and the error (if
+// there is any).
+func GramSchmidt(vs []Vector, tol float64) ([]Vector, error) {
+	var basis []Vector
+
+	for index, v := range vs {
+		if index > 0 && !v.AreDimsEqual(vs[0]) {
+			return nil, fmt.Errorf("Dimensions must match")
+		}
+
+		u := make(Vector, len(v))
+		copy(u, v)
+		initial := u.norm2()
+		if initial == 0 {
+			continue
+		}
+
+		for pass := 0; pass < 2; pass++ {
+			for _, q := range basis {
+				var d float64
+				for i := range u {
+					d += q[i] * u[i]
+				}
+				for i := range u {
+					u[i] -= d * q[i]
+				}
+			}
+		}
+
+		nrm := u.norm2()
+		if nrm <= tol*initial {
+			continue
+		}
+
+		for i := range u {
+			u[i] /= nrm
+		}
+		basis = append(basis, u)
+	}
+
+	return basis, nil
+}
@@ -0,0 +1,81 @@
+package numericalgo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGramSchmidt(t *testing.T) {
+	cases := map[string]struct {
+		vectors        []numericalgo.Vector
+		expectedResult []numericalgo.Vector
+		expectedError  error
+	}{
+		"basic orthonormalization": {
+			vectors: []numericalgo.Vector{
+				{3, 1},
+				{2, 2},
+			},
+			expectedResult: []numericalgo.Vector{
+				{0.9486832980505138, 0.31622776601683794},
+				{-0.31622776601683794, 0.9486832980505138},
+			},
+			expectedError: nil,
+		},
+		"dependent vectors are dropped": {
+			vectors: []numericalgo.Vector{
+				{1, 0, 0},
+				{2, 0, 0},
+				{1, 1, 0},
+				{0, 0, 0},
+			},
+			expectedResult: []numericalgo.Vector{
+				{1, 0, 0},
+				{0, 1, 0},
+			},
+			expectedError: nil,
+		},
+		"vectors with different dimensions": {
+			vectors: []numericalgo.Vector{
+				{1, 0},
+				{1, 0, 0},
+			},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Dimensions must match"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := numericalgo.GramSchmidt(c.vectors, 1e-10)
+			assert.Equal(t, len(c.expectedResult), len(result))
+			for i := range result {
+				assert.True(t, result[i].IsSimilar(c.expectedResult[i], 1e-12))
+			}
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestGramSchmidtIllConditioned(t *testing.T) {
+	eps := 1e-8
+	vectors := []numericalgo.Vector{
+		{1, eps, 0, 0},
+		{1, 0, eps, 0},
+		{1, 0, 0, eps},
+	}
+
+	basis, err := numericalgo.GramSchmidt(vectors, 1e-14)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(basis))
+
+	for i := range basis {
+		for j := i + 1; j < len(basis); j++ {
+			d, _ := basis[i].Dot(basis[j])
+			assert.InDelta(t, 0, d, 1e-14)
+		}
+	}
+}
@@ -7,7 +7,8 @@ import (
 )
 
 // Simpson is a function which accepts function, left, right bounds and n number of subdivisions. It returns the integration
-// value of the function in the given bounds using simpson rule.
+// value of the function in the given bounds using simpson rule. The function values are added with Neumaier
+// summation, so the rounding error stays far below the discretization error even for millions of subdivisions.
 func Simpson(f func(float64) float64, l, r float64, n int) (float64, error) {
 
 	var eval, evalOdd, evalEven numericalgo.Vector
@@ -32,5 +33,5 @@ func Simpson(f func(float64) float64, l, r float64, n int) (float64, error) {
 		}
 	}
 
-	return h / 3 * (eval[0] + eval[n] + 4*evalOdd.Sum() + 2*evalEven.Sum()), nil
+	return h / 3 * (eval[0] + eval[n] + 4*evalOdd.SumWith(numericalgo.Neumaier) + 2*evalEven.SumWith(numericalgo.Neumaier)), nil
 }
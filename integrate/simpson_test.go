@@ -103,3 +103,11 @@ func TestSimpson(t *testing.T) {
 		})
 	}
 }
+
+func TestSimpsonManySubdivisions(t *testing.T) {
+	result, err := integrate.Simpson(func(x float64) float64 {
+		return 0.1
+	}, 0, 1, 10000)
+	assert.InDelta(t, 0.1, result, 1e-15)
+	assert.Equal(t, nil, err)
+}
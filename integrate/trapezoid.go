@@ -7,7 +7,8 @@ import (
 )
 
 // Trapezoid is a function which accepts function, left, right bounds and n number of subdivisions. It returns the integration
-// value of the function in the given bounds using trapezoidal rule.
+// value of the function in the given bounds using trapezoidal rule. The function values are added with Neumaier
+// summation, so the rounding error stays far below the discretization error even for millions of subdivisions.
 func Trapezoid(f func(float64) float64, l, r float64, n int) (float64, error) {
 
 	var eval numericalgo.Vector
@@ -24,5 +25,5 @@ func Trapezoid(f func(float64) float64, l, r float64, n int) (float64, error) {
 		eval = append(eval, f(x))
 	}
 
-	return h * ((eval[0]+eval[n])/2 + eval[1:n].Sum()), nil
+	return h * ((eval[0]+eval[n])/2 + eval[1:n].SumWith(numericalgo.Neumaier)), nil
 }
@@ -103,3 +103,11 @@ func TestTrapezoid(t *testing.T) {
 		})
 	}
 }
+
+func TestTrapezoidManySubdivisions(t *testing.T) {
+	result, err := integrate.Trapezoid(func(x float64) float64 {
+		return 0.1
+	}, 0, 1, 10000)
+	assert.InDelta(t, 0.1, result, 1e-15)
+	assert.Equal(t, nil, err)
+}
@@ -1,8 +1,6 @@
 package interpolate
 
 import (
-	"fmt"
-
 	"github.com/DzananGanic/numericalgo"
 )
 
@@ -16,12 +14,13 @@ type Base struct {
 // Fit receives two float64 slices - for the x and y coordinates where x[i] and y[i] represent a coordinate pair in a grid.
 // It returns the error if the X and Y sizes do not match.
 func (b *Base) Fit(x, y []float64) error {
-	if len(x) != len(y) {
-		return fmt.Errorf("X and Y sizes do not match")
+	xy, err := numericalgo.SlicesToCoordinatePairsChecked(x, y)
+	if err != nil {
+		return err
 	}
 	b.X = x
 	b.Y = y
-	b.XYPairs = numericalgo.SlicesToCoordinatePairs(x, y)
+	b.XYPairs = xy
 	numericalgo.SortCoordinatePairs(b.XYPairs)
 	return nil
 }
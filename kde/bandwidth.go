@@ -0,0 +1,164 @@
+package kde
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+)
+
+// Selector is the method used to choose the bandwidth of a density estimate.
+type Selector int
+
+const (
+	// Silverman is Silverman's rule of thumb 0.9*min(sd, IQR/1.34)*n^(-1/5), which is robust to outliers and
+	// avoids oversmoothing moderately multimodal data.
+	Silverman Selector = iota
+	// Scott is the normal reference rule 1.06*sd*n^(-1/5), which is optimal for normally distributed data.
+	Scott
+	// LSCV is least-squares cross-validation, which minimizes an unbiased estimate of the integrated squared error
+	// and adapts to the shape of the data at the cost of O(n^2) work per evaluated bandwidth.
+	LSCV
+)
+
+// SilvermanBandwidth receives the data and the kernel. It returns the bandwidth of Silverman's rule of thumb and
+// the error (if there is any). The rule is derived for the Gaussian kernel, so for other kernels it is rescaled by
+// the ratio of the canonical bandwidths to give the same amount of smoothing.
+func SilvermanBandwidth(x numericalgo.Vector, kernel Kernel) (float64, error) {
+	sd, iqr, err := spread(x)
+	if err != nil {
+		return 0, err
+	}
+
+	s := sd
+	if iqr > 0 {
+		s = math.Min(sd, iqr/1.34)
+	}
+	return 0.9 * s * math.Pow(float64(x.Dim()), -0.2) * kernel.canonicalRatio(), nil
+}
+
+// ScottBandwidth receives the data and the kernel. It returns the bandwidth of the normal reference rule and the
+// error (if there is any). Like SilvermanBandwidth, it is rescaled for kernels other than Gaussian.
+func ScottBandwidth(x numericalgo.Vector, kernel Kernel) (float64, error) {
+	sd, _, err := spread(x)
+	if err != nil {
+		return 0, err
+	}
+	return 1.06 * sd * math.Pow(float64(x.Dim()), -0.2) * kernel.canonicalRatio(), nil
+}
+
+// LSCVBandwidth receives the data and the kernel. It returns the bandwidth minimizing the least-squares
+// cross-validation criterion and the error (if there is any). The criterion is evaluated on a logarithmic grid
+// between 1/50 and 4 times the normal reference bandwidth, and the best grid point is refined with golden section
+// search.
+func LSCVBandwidth(x numericalgo.Vector, kernel Kernel) (float64, error) {
+	h, err := ScottBandwidth(x, kernel)
+	if err != nil {
+		return 0, err
+	}
+
+	data := make(numericalgo.Matrix, x.Dim())
+	for i := range data {
+		data[i] = numericalgo.Vector{x[i]}
+	}
+
+	c := minimizeLSCV(data, numericalgo.Vector{h}, kernel)
+	return c * h, nil
+}
+
+// spread returns the sample standard deviation and the interquartile range of the data.
+func spread(x numericalgo.Vector) (float64, float64, error) {
+	if x.Dim() < 2 {
+		return 0, 0, fmt.Errorf("Data must have at least two elements")
+	}
+
+	sd, err := stats.StdDev(x, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if sd == 0 {
+		return 0, 0, fmt.Errorf("Data cannot be constant")
+	}
+
+	q, err := stats.Quantiles(x, numericalgo.Vector{0.25, 0.75}, stats.Linear)
+	if err != nil {
+		return 0, 0, err
+	}
+	return sd, q[1] - q[0], nil
+}
+
+// minimizeLSCV returns the factor c for which the bandwidths c*h minimize the least-squares cross-validation
+// criterion of the product kernel estimate of the data.
+func minimizeLSCV(data numericalgo.Matrix, h numericalgo.Vector, kernel Kernel) float64 {
+	const (
+		points = 40
+		lo     = 1.0 / 50
+		hi     = 4.0
+	)
+
+	score := func(logC float64) float64 {
+		return lscv(data, h, math.Exp(logC), kernel)
+	}
+
+	step := (math.Log(hi) - math.Log(lo)) / (points - 1)
+	best, bestScore := 0, math.Inf(1)
+	for i := 0; i < points; i++ {
+		s := score(math.Log(lo) + float64(i)*step)
+		if s < bestScore {
+			best, bestScore = i, s
+		}
+	}
+
+	a := math.Log(lo) + float64(best-1)*step
+	b := math.Log(lo) + float64(best+1)*step
+	if best == 0 || best == points-1 {
+		return math.Exp(math.Log(lo) + float64(best)*step)
+	}
+
+	// Golden section search between the neighbours of the best grid point.
+	g := (math.Sqrt(5) - 1) / 2
+	c, d := b-g*(b-a), a+g*(b-a)
+	fc, fd := score(c), score(d)
+	for b-a > 1e-6 {
+		if fc < fd {
+			b, d, fd = d, c, fc
+			c = b - g*(b-a)
+			fc = score(c)
+		} else {
+			a, c, fc = c, d, fd
+			d = a + g*(b-a)
+			fd = score(d)
+		}
+	}
+	return math.Exp((a + b) / 2)
+}
+
+// lscv returns the least-squares cross-validation criterion integral(f^2) - 2/n*sum(f_{-i}(x_i)) of the product
+// kernel estimate with the bandwidths c*h, where f_{-i} is the estimate without the i-th observation.
+func lscv(data numericalgo.Matrix, h numericalgo.Vector, c float64, kernel Kernel) float64 {
+	n := float64(len(data))
+	det := 1.0
+	for _, hj := range h {
+		det *= c * hj
+	}
+
+	var conv, leaveOut float64
+	for i := range data {
+		// The diagonal terms of the integral of f^2 all equal (K*K)(0)^d.
+		conv += math.Pow(kernel.convolve(0), float64(len(h)))
+		for j := i + 1; j < len(data); j++ {
+			kc, k := 1.0, 1.0
+			for d, hd := range h {
+				u := (data[i][d] - data[j][d]) / (c * hd)
+				kc *= kernel.convolve(u)
+				k *= kernel.eval(u)
+			}
+			conv += 2 * kc
+			leaveOut += 2 * k
+		}
+	}
+
+	return conv/(n*n*det) - 2*leaveOut/(n*(n-1)*det)
+}
@@ -0,0 +1,68 @@
+package kde_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/kde"
+	"github.com/stretchr/testify/assert"
+)
+
+var bimodal = numericalgo.Vector{1.2, 2.3, 2.9, 3.1, 3.8, 4.4, 5.0, 7.5, 8.1, 8.6, 9.0, 9.9}
+
+func TestBandwidths(t *testing.T) {
+	cases := map[string]struct {
+		bandwidth func(numericalgo.Vector, kde.Kernel) (float64, error)
+		kernel    kde.Kernel
+		expected  float64
+		tol       float64
+	}{
+		"silverman": {
+			bandwidth: kde.SilvermanBandwidth,
+			kernel:    kde.Gaussian,
+			expected:  1.630733175950019,
+			tol:       1e-14,
+		},
+		"scott": {
+			bandwidth: kde.ScottBandwidth,
+			kernel:    kde.Gaussian,
+			expected:  1.9206412961189112,
+			tol:       1e-14,
+		},
+		"scott epanechnikov": {
+			bandwidth: kde.ScottBandwidth,
+			kernel:    kde.Epanechnikov,
+			expected:  4.251924073157138,
+			tol:       1e-14,
+		},
+		"lscv gaussian": {
+			bandwidth: kde.LSCVBandwidth,
+			kernel:    kde.Gaussian,
+			expected:  1.5527,
+			tol:       1e-4,
+		},
+		"lscv epanechnikov": {
+			bandwidth: kde.LSCVBandwidth,
+			kernel:    kde.Epanechnikov,
+			expected:  2.8051,
+			tol:       1e-4,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			h, err := c.bandwidth(bimodal, c.kernel)
+			assert.Nil(t, err)
+			assert.InEpsilon(t, c.expected, h, c.tol)
+		})
+	}
+}
+
+func TestBandwidthInvalid(t *testing.T) {
+	_, err := kde.SilvermanBandwidth(numericalgo.Vector{1}, kde.Gaussian)
+	assert.Equal(t, fmt.Errorf("Data must have at least two elements"), err)
+
+	_, err = kde.ScottBandwidth(numericalgo.Vector{2, 2, 2}, kde.Gaussian)
+	assert.Equal(t, fmt.Errorf("Data cannot be constant"), err)
+}
@@ -0,0 +1,74 @@
+package kde
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// KDE is the univariate kernel density estimate f(x) = 1/(n*h) * sum(K((x - x_i)/h)). After fitting, it predicts
+// the estimated density at any point, so it can be used wherever the fit models are, for example with
+// fit.PredictMulti or as the integrand of the integrators.
+type KDE struct {
+	Kernel    Kernel
+	Selector  Selector
+	Bandwidth float64
+	x         numericalgo.Vector
+}
+
+// New receives the kernel and the bandwidth selector and returns the pointer to the new KDE type.
+func New(kernel Kernel, selector Selector) *KDE {
+	k := &KDE{Kernel: kernel, Selector: selector}
+	return k
+}
+
+// Fit receives the data, stores it and chooses the bandwidth with the selector. It returns the error if something
+// went wrong. The Bandwidth property can be overwritten after fitting to use a fixed bandwidth.
+func (k *KDE) Fit(x numericalgo.Vector) error {
+	if !k.Kernel.valid() {
+		return fmt.Errorf("Unknown kernel")
+	}
+
+	for _, val := range x {
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			return fmt.Errorf("Data must be finite")
+		}
+	}
+
+	var h float64
+	var err error
+	switch k.Selector {
+	case Silverman:
+		h, err = SilvermanBandwidth(x, k.Kernel)
+	case Scott:
+		h, err = ScottBandwidth(x, k.Kernel)
+	case LSCV:
+		h, err = LSCVBandwidth(x, k.Kernel)
+	default:
+		err = fmt.Errorf("Unknown bandwidth selector")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	k.x = make(numericalgo.Vector, x.Dim())
+	copy(k.x, x)
+	k.Bandwidth = h
+	return nil
+}
+
+// Predict receives the point and returns the estimated density at that point. It returns NaN if the estimate has
+// not been fitted.
+func (k *KDE) Predict(val float64) float64 {
+	if k.x.Dim() == 0 {
+		return math.NaN()
+	}
+
+	var sum float64
+	for _, xi := range k.x {
+		sum += k.Kernel.eval((val - xi) / k.Bandwidth)
+	}
+	return sum / (float64(k.x.Dim()) * k.Bandwidth)
+}
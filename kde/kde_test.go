@@ -0,0 +1,88 @@
+package kde_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/fit"
+	"github.com/DzananGanic/numericalgo/kde"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKDEPredict(t *testing.T) {
+	e := kde.New(kde.Gaussian, kde.Silverman)
+	err := e.Fit(bimodal)
+	assert.Nil(t, err)
+	assert.InEpsilon(t, 1.630733175950019, e.Bandwidth, 1e-14)
+
+	var expected float64
+	for _, xi := range bimodal {
+		u := (5 - xi) / e.Bandwidth
+		expected += math.Exp(-u*u/2) / math.Sqrt(2*math.Pi)
+	}
+	expected /= float64(len(bimodal)) * e.Bandwidth
+	assert.InEpsilon(t, expected, e.Predict(5), 1e-14)
+
+	predicted := fit.PredictMulti(e, numericalgo.Vector{5, 100})
+	assert.InEpsilon(t, expected, predicted[0], 1e-14)
+	assert.Equal(t, 0.0, predicted[1])
+}
+
+func TestKDENormalSample(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	x := make(numericalgo.Vector, 2000)
+	for i := range x {
+		x[i] = r.NormFloat64()
+	}
+
+	for _, s := range []kde.Selector{kde.Silverman, kde.Scott} {
+		e := kde.New(kde.Epanechnikov, s)
+		assert.Nil(t, e.Fit(x))
+		for _, val := range []float64{-1, 0, 1} {
+			assert.InDelta(t, math.Exp(-val*val/2)/math.Sqrt(2*math.Pi), e.Predict(val), 0.02)
+		}
+	}
+}
+
+func TestKDEUnfitted(t *testing.T) {
+	e := kde.New(kde.Gaussian, kde.Scott)
+	assert.True(t, math.IsNaN(e.Predict(0)))
+}
+
+func TestKDEFitInvalid(t *testing.T) {
+	cases := map[string]struct {
+		kernel   kde.Kernel
+		selector kde.Selector
+		x        numericalgo.Vector
+		err      error
+	}{
+		"unknown kernel": {
+			kernel:   kde.Kernel(42),
+			selector: kde.Scott,
+			x:        bimodal,
+			err:      fmt.Errorf("Unknown kernel"),
+		},
+		"unknown selector": {
+			kernel:   kde.Gaussian,
+			selector: kde.Selector(42),
+			x:        bimodal,
+			err:      fmt.Errorf("Unknown bandwidth selector"),
+		},
+		"non-finite data": {
+			kernel:   kde.Gaussian,
+			selector: kde.Scott,
+			x:        numericalgo.Vector{1, math.NaN()},
+			err:      fmt.Errorf("Data must be finite"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := kde.New(c.kernel, c.selector).Fit(c.x)
+			assert.Equal(t, c.err, err)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package kde
+
+import "math"
+
+// Kernel is the smoothing kernel of a density estimate. All kernels are symmetric probability densities; the
+// compactly supported ones vanish outside [-1, 1].
+type Kernel int
+
+const (
+	// Gaussian is the standard normal density.
+	Gaussian Kernel = iota
+	// Epanechnikov is 3/4*(1 - u^2), the kernel with the smallest asymptotic mean integrated squared error.
+	Epanechnikov
+	// Uniform is the rectangular kernel 1/2.
+	Uniform
+	// Triangular is 1 - |u|.
+	Triangular
+	// Biweight is 15/16*(1 - u^2)^2, also known as the quartic kernel.
+	Biweight
+	// Triweight is 35/32*(1 - u^2)^3.
+	Triweight
+	// Cosine is pi/4*cos(pi*u/2).
+	Cosine
+)
+
+func (k Kernel) eval(u float64) float64 {
+	if k == Gaussian {
+		return math.Exp(-u*u/2) / math.Sqrt(2*math.Pi)
+	}
+
+	if u < -1 || u > 1 {
+		return 0
+	}
+
+	switch k {
+	case Epanechnikov:
+		return 0.75 * (1 - u*u)
+	case Uniform:
+		return 0.5
+	case Triangular:
+		return 1 - math.Abs(u)
+	case Biweight:
+		return 15.0 / 16 * (1 - u*u) * (1 - u*u)
+	case Triweight:
+		return 35.0 / 32 * (1 - u*u) * (1 - u*u) * (1 - u*u)
+	case Cosine:
+		return math.Pi / 4 * math.Cos(math.Pi*u/2)
+	}
+	return math.NaN()
+}
+
+// valid reports whether k is one of the defined kernels.
+func (k Kernel) valid() bool {
+	return k >= Gaussian && k <= Cosine
+}
+
+// roughness returns the integral of K(u)^2.
+func (k Kernel) roughness() float64 {
+	switch k {
+	case Gaussian:
+		return 1 / (2 * math.Sqrt(math.Pi))
+	case Epanechnikov:
+		return 3.0 / 5
+	case Uniform:
+		return 1.0 / 2
+	case Triangular:
+		return 2.0 / 3
+	case Biweight:
+		return 5.0 / 7
+	case Triweight:
+		return 350.0 / 429
+	case Cosine:
+		return math.Pi * math.Pi / 16
+	}
+	return math.NaN()
+}
+
+// variance returns the integral of u^2*K(u).
+func (k Kernel) variance() float64 {
+	switch k {
+	case Gaussian:
+		return 1
+	case Epanechnikov:
+		return 1.0 / 5
+	case Uniform:
+		return 1.0 / 3
+	case Triangular:
+		return 1.0 / 6
+	case Biweight:
+		return 1.0 / 7
+	case Triweight:
+		return 1.0 / 9
+	case Cosine:
+		return 1 - 8/(math.Pi*math.Pi)
+	}
+	return math.NaN()
+}
+
+// canonicalRatio returns the ratio of the canonical bandwidths of k and the Gaussian kernel. Multiplying a
+// bandwidth chosen for the Gaussian kernel by this ratio gives the equivalent amount of smoothing with k.
+func (k Kernel) canonicalRatio() float64 {
+	canonical := func(k Kernel) float64 {
+		return math.Pow(k.roughness()/(k.variance()*k.variance()), 0.2)
+	}
+	return canonical(k) / canonical(Gaussian)
+}
+
+// gaussLegendre8 holds the nodes and weights of the 8-point Gauss-Legendre rule on [-1, 1].
+var gaussLegendre8 = [4][2]float64{
+	{0.1834346424956498, 0.3626837833783620},
+	{0.5255324099163290, 0.3137066458778873},
+	{0.7966664774136267, 0.2223810344533745},
+	{0.9602898564975363, 0.1012285362903763},
+}
+
+// convolve returns the convolution (K*K)(t), the density of the sum of two independent samples of the kernel.
+func (k Kernel) convolve(t float64) float64 {
+	if k == Gaussian {
+		return math.Exp(-t*t/4) / math.Sqrt(4*math.Pi)
+	}
+
+	t = math.Abs(t)
+	if t >= 2 {
+		return 0
+	}
+
+	// The integrand K(u)*K(t-u) is smooth between the kinks of the two kernels, so every piece is integrated with
+	// a Gauss-Legendre rule, which is exact for the polynomial kernels.
+	breaks := []float64{t - 1}
+	for _, b := range []float64{0, t} {
+		if b > breaks[len(breaks)-1] && b < 1 {
+			breaks = append(breaks, b)
+		}
+	}
+	breaks = append(breaks, 1)
+
+	var r float64
+	for i := 0; i+1 < len(breaks); i++ {
+		a, b := breaks[i], breaks[i+1]
+		mid, half := (a+b)/2, (b-a)/2
+		for _, nw := range gaussLegendre8 {
+			for _, u := range []float64{mid - half*nw[0], mid + half*nw[0]} {
+				r += half * nw[1] * k.eval(u) * k.eval(t-u)
+			}
+		}
+	}
+	return r
+}
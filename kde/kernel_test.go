@@ -0,0 +1,62 @@
+package kde_test
+
+import (
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/integrate"
+	"github.com/DzananGanic/numericalgo/kde"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKernels(t *testing.T) {
+	cases := map[string]struct {
+		kernel kde.Kernel
+		atZero float64
+	}{
+		"gaussian": {
+			kernel: kde.Gaussian,
+			atZero: 0.3520653267642995,
+		},
+		"epanechnikov": {
+			kernel: kde.Epanechnikov,
+			atZero: 0.5625,
+		},
+		"uniform": {
+			kernel: kde.Uniform,
+			atZero: 0.5,
+		},
+		"triangular": {
+			kernel: kde.Triangular,
+			atZero: 0.5,
+		},
+		"biweight": {
+			kernel: kde.Biweight,
+			atZero: 0.52734375,
+		},
+		"triweight": {
+			kernel: kde.Triweight,
+			atZero: 0.46142578125,
+		},
+		"cosine": {
+			kernel: kde.Cosine,
+			atZero: 0.5553603672697958,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			// With unit bandwidth the estimate is the average of the kernels centered at the observations.
+			e := kde.New(c.kernel, kde.Scott)
+			assert.Nil(t, e.Fit(numericalgo.Vector{-0.5, 0.5}))
+			e.Bandwidth = 1
+
+			assert.InEpsilon(t, c.atZero, e.Predict(0), 1e-14)
+
+			// The jumps of the uniform kernel limit the accuracy of the quadrature to O(h).
+			integral, err := integrate.Simpson(e.Predict, -10, 10, 200000)
+			assert.Nil(t, err)
+			assert.InDelta(t, 1, integral, 1e-4)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package kde
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+)
+
+// Multivariate is the multivariate kernel density estimate with a product kernel and one bandwidth per dimension,
+// f(x) = 1/(n*h_1*...*h_d) * sum(prod_j K((x_j - x_ij)/h_j)).
+type Multivariate struct {
+	Kernel    Kernel
+	Selector  Selector
+	Bandwidth numericalgo.Vector
+	x         numericalgo.Matrix
+}
+
+// NewMultivariate receives the kernel and the bandwidth selector and returns the pointer to the new Multivariate
+// type.
+func NewMultivariate(kernel Kernel, selector Selector) *Multivariate {
+	m := &Multivariate{Kernel: kernel, Selector: selector}
+	return m
+}
+
+// Fit receives the data with one observation per row, stores it and chooses the bandwidths with the selector. It
+// returns the error if something went wrong. Scott's rule uses h_j = sd_j * n^(-1/(d+4)) and Silverman's rule
+// h_j = sd_j * (4/((d+2)*n))^(1/(d+4)), both rescaled for kernels other than Gaussian. LSCV minimizes the
+// cross-validation criterion over a common factor of Scott's bandwidths.
+func (m *Multivariate) Fit(x numericalgo.Matrix) error {
+	if !m.Kernel.valid() {
+		return fmt.Errorf("Unknown kernel")
+	}
+
+	rows, cols := x.Dim()
+	if rows < 2 || cols == 0 {
+		return fmt.Errorf("Data must have at least two observations")
+	}
+
+	for i := range x {
+		if len(x[i]) != cols {
+			return fmt.Errorf("All observations must have the same dimension")
+		}
+	}
+
+	cov, err := stats.Covariance(x, 1)
+	if err != nil {
+		return err
+	}
+
+	n, d := float64(rows), float64(cols)
+	h := make(numericalgo.Vector, cols)
+	for j := range h {
+		if cov[j][j] == 0 {
+			return fmt.Errorf("Data cannot be constant in any dimension")
+		}
+		h[j] = math.Sqrt(cov[j][j]) * math.Pow(n, -1/(d+4)) * m.Kernel.canonicalRatio()
+	}
+
+	switch m.Selector {
+	case Silverman:
+		for j := range h {
+			h[j] *= math.Pow(4/(d+2), 1/(d+4))
+		}
+	case Scott:
+	case LSCV:
+		c := minimizeLSCV(x, h, m.Kernel)
+		for j := range h {
+			h[j] *= c
+		}
+	default:
+		return fmt.Errorf("Unknown bandwidth selector")
+	}
+
+	m.x = make(numericalgo.Matrix, rows)
+	for i := range x {
+		m.x[i] = make(numericalgo.Vector, cols)
+		copy(m.x[i], x[i])
+	}
+	m.Bandwidth = h
+	return nil
+}
+
+// Predict receives the point and returns the estimated density at that point, and the error (if there is any).
+func (m *Multivariate) Predict(val numericalgo.Vector) (float64, error) {
+	if len(m.x) == 0 {
+		return 0, fmt.Errorf("Density estimate has not been fitted")
+	}
+
+	if val.Dim() != m.Bandwidth.Dim() {
+		return 0, fmt.Errorf("Dimensions must match")
+	}
+
+	det := 1.0
+	for _, h := range m.Bandwidth {
+		det *= h
+	}
+
+	var sum float64
+	for _, xi := range m.x {
+		k := 1.0
+		for j, h := range m.Bandwidth {
+			k *= m.Kernel.eval((val[j] - xi[j]) / h)
+			if k == 0 {
+				break
+			}
+		}
+		sum += k
+	}
+	return sum / (float64(len(m.x)) * det), nil
+}
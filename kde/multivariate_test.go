@@ -0,0 +1,68 @@
+package kde_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/kde"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultivariateOneDimension(t *testing.T) {
+	// In one dimension the multivariate estimate with Scott's bandwidth scaled to the univariate rule must agree
+	// with the univariate estimate.
+	x := make(numericalgo.Matrix, len(bimodal))
+	for i := range x {
+		x[i] = numericalgo.Vector{bimodal[i]}
+	}
+
+	m := kde.NewMultivariate(kde.Gaussian, kde.Scott)
+	assert.Nil(t, m.Fit(x))
+
+	u := kde.New(kde.Gaussian, kde.Scott)
+	assert.Nil(t, u.Fit(bimodal))
+	u.Bandwidth = m.Bandwidth[0]
+
+	for _, val := range []float64{0, 3, 6.2, 10} {
+		p, err := m.Predict(numericalgo.Vector{val})
+		assert.Nil(t, err)
+		assert.InEpsilon(t, u.Predict(val), p, 1e-14)
+	}
+}
+
+func TestMultivariateNormalSample(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	x := make(numericalgo.Matrix, 3000)
+	for i := range x {
+		x[i] = numericalgo.Vector{r.NormFloat64(), 2 * r.NormFloat64()}
+	}
+
+	for _, s := range []kde.Selector{kde.Silverman, kde.Scott, kde.LSCV} {
+		m := kde.NewMultivariate(kde.Gaussian, s)
+		assert.Nil(t, m.Fit(x[:500]))
+		assert.InEpsilon(t, 2, m.Bandwidth[1]/m.Bandwidth[0], 0.2)
+
+		p, err := m.Predict(numericalgo.Vector{0, 0})
+		assert.Nil(t, err)
+		assert.InDelta(t, 1/(4*math.Pi), p, 0.02)
+	}
+}
+
+func TestMultivariateInvalid(t *testing.T) {
+	m := kde.NewMultivariate(kde.Gaussian, kde.Scott)
+	_, err := m.Predict(numericalgo.Vector{0})
+	assert.Equal(t, fmt.Errorf("Density estimate has not been fitted"), err)
+
+	err = m.Fit(numericalgo.Matrix{{1, 2}, {3}})
+	assert.Equal(t, fmt.Errorf("All observations must have the same dimension"), err)
+
+	err = m.Fit(numericalgo.Matrix{{1, 2}, {3, 2}})
+	assert.Equal(t, fmt.Errorf("Data cannot be constant in any dimension"), err)
+
+	assert.Nil(t, m.Fit(numericalgo.Matrix{{1, 2}, {3, 4}, {0, 1}}))
+	_, err = m.Predict(numericalgo.Vector{0})
+	assert.Equal(t, fmt.Errorf("Dimensions must match"), err)
+}
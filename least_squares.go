@@ -0,0 +1,382 @@
+package numericalgo
+
+import (
+	"fmt"
+	"math"
+)
+
+// LeftDivideRidge receives another matrix and the regularization parameter lambda. It solves the Tikhonov (ridge)
+// regularized least squares problem min ||A*x - b||^2 + lambda*||x||^2 for every column b of the 2nd matrix, and
+// returns the results in matrix form and error (if there is any). The problem is solved with the QR decomposition of
+// the augmented matrix [A; sqrt(lambda)*I], so the normal equations are never formed.
+func (m Matrix) LeftDivideRidge(m2 Matrix, lambda float64) (Matrix, error) {
+	if lambda < 0 {
+		return nil, fmt.Errorf("Regularization parameter cannot be negative")
+	}
+
+	if err := m.checkLeftDivide(m2); err != nil {
+		return nil, err
+	}
+
+	_, cols := m.Dim()
+	a := m.copy()
+	for j := 0; j < cols; j++ {
+		row := make(Vector, cols)
+		row[j] = math.Sqrt(lambda)
+		a = append(a, row)
+	}
+
+	qr, err := a.QR()
+	if err != nil {
+		return nil, err
+	}
+
+	return m.solveColumns(m2, func(b Vector) (Vector, error) {
+		return qr.Solve(append(b, make(Vector, cols)...))
+	})
+}
+
+// LeftDivideNonNegative receives another matrix as a parameter. It solves the non-negative least squares problem
+// min ||A*x - b|| subject to x >= 0 for every column b of the 2nd matrix with the Lawson-Hanson active set method,
+// and returns the results in matrix form and error (if there is any).
+func (m Matrix) LeftDivideNonNegative(m2 Matrix) (Matrix, error) {
+	if err := m.checkLeftDivide(m2); err != nil {
+		return nil, err
+	}
+
+	_, cols := m.Dim()
+	lower := make(Vector, cols)
+	upper := make(Vector, cols)
+	for j := range upper {
+		upper[j] = math.Inf(1)
+	}
+
+	return m.solveColumns(m2, func(b Vector) (Vector, error) {
+		return m.boundedLeastSquares(b, lower, upper)
+	})
+}
+
+// LeftDivideBounded receives another matrix and the lower and upper bounds for every unknown. It solves the bound
+// constrained least squares problem min ||A*x - b|| subject to lower <= x <= upper for every column b of the 2nd
+// matrix, and returns the results in matrix form and error (if there is any). Bounds may be infinite. The problem is
+// solved with the active set method of Stark and Parker (BVLS), a generalization of Lawson-Hanson NNLS.
+func (m Matrix) LeftDivideBounded(m2 Matrix, lower, upper Vector) (Matrix, error) {
+	if err := m.checkLeftDivide(m2); err != nil {
+		return nil, err
+	}
+
+	_, cols := m.Dim()
+	if lower.Dim() != cols || upper.Dim() != cols {
+		return nil, fmt.Errorf("Bounds must have one element per column of the matrix")
+	}
+
+	for j := range lower {
+		if lower[j] > upper[j] {
+			return nil, fmt.Errorf("Lower bound cannot be greater than upper bound")
+		}
+	}
+
+	return m.solveColumns(m2, func(b Vector) (Vector, error) {
+		return m.boundedLeastSquares(b, lower, upper)
+	})
+}
+
+// LeftDivideConstrained receives another matrix, the constraint matrix C and the constraint right-hand sides D. It
+// solves the equality constrained least squares problem min ||A*x - b|| subject to C*x = d for every column b of
+// the 2nd matrix and the corresponding column d of D, and returns the results in matrix form and error (if there is
+// any). The constraints are eliminated with the null space method: x = xp + Z*y, where xp satisfies the constraints
+// and the columns of Z span the null space of C.
+func (m Matrix) LeftDivideConstrained(m2, c, d Matrix) (Matrix, error) {
+	if err := m.checkLeftDivide(m2); err != nil {
+		return nil, err
+	}
+
+	if c.isNil() || d.isNil() {
+		return nil, fmt.Errorf("Matrices cannot be nil")
+	}
+
+	_, cols := m.Dim()
+	_, cols2 := m2.Dim()
+	cRows, cCols := c.Dim()
+	dRows, dCols := d.Dim()
+	if cCols != cols {
+		return nil, fmt.Errorf("The number of columns of the constraint matrix must equal the number of columns of the 1st matrix")
+	} else if dRows != cRows || dCols != cols2 {
+		return nil, fmt.Errorf("Constraint right-hand side dimensions must match")
+	}
+
+	cQR, err := c.QRPivot()
+	if err != nil {
+		return nil, err
+	}
+
+	z, err := c.NullSpace(0)
+	if err != nil {
+		return nil, err
+	}
+
+	_, free := z.Dim()
+	var az Matrix
+	var azQR *QR
+	if free > 0 {
+		az, err = m.MultiplyBy(z)
+		if err != nil {
+			return nil, err
+		}
+		azQR, err = az.QRPivot()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r := make(Matrix, cols)
+	for i := range r {
+		r[i] = make(Vector, cols2)
+	}
+
+	for k := 0; k < cols2; k++ {
+		b, _ := m2.Col(k)
+		dk, _ := d.Col(k)
+
+		xp, err := cQR.Solve(dk)
+		if err != nil {
+			return nil, err
+		}
+
+		cx := c.apply(xp)
+		for i := range cx {
+			scale := math.Abs(dk[i])
+			for j := range xp {
+				scale += math.Abs(c[i][j] * xp[j])
+			}
+			if math.Abs(cx[i]-dk[i]) > 1e3*machineEpsilon(false)*scale {
+				return nil, fmt.Errorf("Equality constraints are inconsistent")
+			}
+		}
+
+		x := xp
+		if free > 0 {
+			ax := m.apply(xp)
+			rhs := make(Vector, len(b))
+			for i := range rhs {
+				rhs[i] = b[i] - ax[i]
+			}
+
+			y, err := azQR.Solve(rhs)
+			if err != nil {
+				return nil, err
+			}
+
+			zy := z.apply(y)
+			for i := range x {
+				x[i] += zy[i]
+			}
+		}
+
+		for i := range x {
+			r[i][k] = x[i]
+		}
+	}
+
+	return r, nil
+}
+
+// boundedLeastSquares solves min ||A*x - b|| subject to lower <= x <= upper with the Stark-Parker active set method.
+// With lower = 0 and upper = +Inf it reduces to the Lawson-Hanson NNLS algorithm.
+func (m Matrix) boundedLeastSquares(b, lower, upper Vector) (Vector, error) {
+	rows, cols := m.Dim()
+
+	const (
+		free = iota
+		atLower
+		atUpper
+	)
+
+	state := make([]int, cols)
+	x := make(Vector, cols)
+	for j := range x {
+		switch {
+		case !math.IsInf(lower[j], -1):
+			state[j], x[j] = atLower, lower[j]
+		case !math.IsInf(upper[j], 1):
+			state[j], x[j] = atUpper, upper[j]
+		default:
+			state[j] = free
+		}
+	}
+
+	var aNorm float64
+	for j := 0; j < cols; j++ {
+		var s float64
+		for i := 0; i < rows; i++ {
+			s += math.Abs(m[i][j])
+		}
+		aNorm = math.Max(aNorm, s)
+	}
+	tol := 10 * machineEpsilon(false) * aNorm * float64(rows+cols)
+
+	maxIter := 10 * (cols + 1)
+	for iter := 0; ; iter++ {
+		if iter > maxIter {
+			return nil, fmt.Errorf("Maximum number of iterations exceeded")
+		}
+
+		// Solve for the free variables with the bound variables fixed, stepping back to the feasible region
+		// and releasing variables which hit a bound until the free solution is strictly feasible.
+		for {
+			var freeIdx []int
+			for j := range state {
+				if state[j] == free {
+					freeIdx = append(freeIdx, j)
+				}
+			}
+			if len(freeIdx) == 0 {
+				break
+			}
+
+			z, err := m.solveFree(b, x, freeIdx)
+			if err != nil {
+				return nil, err
+			}
+
+			alpha, limit := 1.0, -1
+			for k, j := range freeIdx {
+				var step float64
+				if z[k] < lower[j] {
+					step = (lower[j] - x[j]) / (z[k] - x[j])
+				} else if z[k] > upper[j] {
+					step = (upper[j] - x[j]) / (z[k] - x[j])
+				} else {
+					continue
+				}
+				if step < alpha {
+					alpha, limit = step, j
+				}
+			}
+
+			for k, j := range freeIdx {
+				x[j] += alpha * (z[k] - x[j])
+			}
+
+			if limit < 0 {
+				break
+			}
+
+			eps := 10 * machineEpsilon(false)
+			for k, j := range freeIdx {
+				if j == limit && z[k] < lower[j] || z[k] < lower[j] && x[j]-lower[j] <= eps*math.Max(1, math.Abs(lower[j])) {
+					state[j], x[j] = atLower, lower[j]
+				} else if j == limit || z[k] > upper[j] && upper[j]-x[j] <= eps*math.Max(1, math.Abs(upper[j])) {
+					state[j], x[j] = atUpper, upper[j]
+				}
+			}
+		}
+
+		// The gradient of -1/2*||A*x - b||^2 tells which bound variable would decrease the residual if released.
+		ax := m.apply(x)
+		r := make(Vector, rows)
+		for i := range r {
+			r[i] = b[i] - ax[i]
+		}
+
+		t, best := -1, tol
+		for j := 0; j < cols; j++ {
+			var w float64
+			for i := 0; i < rows; i++ {
+				w += m[i][j] * r[i]
+			}
+			if state[j] == atLower && w > best || state[j] == atUpper && -w > best {
+				t, best = j, math.Abs(w)
+			}
+		}
+
+		if t < 0 {
+			for j := range x {
+				x[j] = math.Max(lower[j], math.Min(upper[j], x[j]))
+			}
+			return x, nil
+		}
+		state[t] = free
+	}
+}
+
+// solveFree returns the least squares solution for the free variables, with the remaining variables fixed at x.
+func (m Matrix) solveFree(b, x Vector, freeIdx []int) (Vector, error) {
+	isFree := make([]bool, len(x))
+	for _, j := range freeIdx {
+		isFree[j] = true
+	}
+
+	a := make(Matrix, len(m))
+	rhs := make(Vector, len(m))
+	for i := range m {
+		a[i] = make(Vector, len(freeIdx))
+		for k, j := range freeIdx {
+			a[i][k] = m[i][j]
+		}
+		rhs[i] = b[i]
+		for j := range x {
+			if !isFree[j] {
+				rhs[i] -= m[i][j] * x[j]
+			}
+		}
+	}
+
+	qr, err := a.QRPivot()
+	if err != nil {
+		return nil, err
+	}
+	return qr.Solve(rhs)
+}
+
+func (m Matrix) checkLeftDivide(m2 Matrix) error {
+	if m.isNil() || m2.isNil() {
+		return fmt.Errorf("Matrices cannot be nil")
+	}
+
+	rows, _ := m.Dim()
+	rows2, _ := m2.Dim()
+	if rows != rows2 {
+		return fmt.Errorf("The number of rows of the 1st matrix must equal the number of rows of the 2nd matrix")
+	}
+	return nil
+}
+
+// solveColumns applies solve to every column of m2 and collects the solutions as the columns of the result.
+func (m Matrix) solveColumns(m2 Matrix, solve func(Vector) (Vector, error)) (Matrix, error) {
+	_, cols := m.Dim()
+	_, cols2 := m2.Dim()
+
+	r := make(Matrix, cols)
+	for i := range r {
+		r[i] = make(Vector, cols2)
+	}
+
+	for k := 0; k < cols2; k++ {
+		b, err := m2.Col(k)
+		if err != nil {
+			return nil, err
+		}
+
+		x, err := solve(b)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range x {
+			r[i][k] = x[i]
+		}
+	}
+	return r, nil
+}
+
+// apply returns the matrix-vector product A*x.
+func (m Matrix) apply(x Vector) Vector {
+	r := make(Vector, len(m))
+	for i := range m {
+		for j := range m[i] {
+			r[i] += m[i][j] * x[j]
+		}
+	}
+	return r
+}
@@ -0,0 +1,231 @@
+package numericalgo_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatrixLeftDivideRidge(t *testing.T) {
+	cases := map[string]struct {
+		matrix         numericalgo.Matrix
+		b              numericalgo.Matrix
+		lambda         float64
+		expectedResult numericalgo.Matrix
+		expectedError  error
+	}{
+		"ridge regression": {
+			matrix:         numericalgo.Matrix{{1, 0}, {0, 1}, {1, 1}},
+			b:              numericalgo.Matrix{{1}, {2}, {3}},
+			lambda:         1,
+			expectedResult: numericalgo.Matrix{{0.875}, {1.375}},
+			expectedError:  nil,
+		},
+		"zero lambda is ordinary least squares": {
+			matrix:         numericalgo.Matrix{{1, 0}, {0, 1}, {1, 1}},
+			b:              numericalgo.Matrix{{1}, {2}, {3}},
+			lambda:         0,
+			expectedResult: numericalgo.Matrix{{1}, {2}},
+			expectedError:  nil,
+		},
+		"ridge makes collinear system solvable": {
+			matrix:         numericalgo.Matrix{{1, 1}, {2, 2}},
+			b:              numericalgo.Matrix{{2}, {4}},
+			lambda:         1,
+			expectedResult: numericalgo.Matrix{{10.0 / 11}, {10.0 / 11}},
+			expectedError:  nil,
+		},
+		"negative lambda": {
+			matrix:         numericalgo.Matrix{{1, 0}, {0, 1}},
+			b:              numericalgo.Matrix{{1}, {2}},
+			lambda:         -1,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Regularization parameter cannot be negative"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.matrix.LeftDivideRidge(c.b, c.lambda)
+			assert.True(t, result.IsSimilar(c.expectedResult, 1e-12))
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestMatrixLeftDivideNonNegative(t *testing.T) {
+	cases := map[string]struct {
+		matrix         numericalgo.Matrix
+		b              numericalgo.Matrix
+		expectedResult numericalgo.Matrix
+		expectedError  error
+	}{
+		"unconstrained solution is feasible": {
+			matrix:         numericalgo.Matrix{{1, 0}, {0, 1}, {1, 1}},
+			b:              numericalgo.Matrix{{1}, {2}, {3}},
+			expectedResult: numericalgo.Matrix{{1}, {2}},
+			expectedError:  nil,
+		},
+		"negative coefficient is clamped": {
+			matrix:         numericalgo.Matrix{{1, 1}, {1, 2}, {1, 3}},
+			b:              numericalgo.Matrix{{3}, {2}, {1}},
+			expectedResult: numericalgo.Matrix{{2}, {0}},
+			expectedError:  nil,
+		},
+		"multiple right-hand sides": {
+			matrix:         numericalgo.Matrix{{1, 0}, {1, 0}, {0, 1}},
+			b:              numericalgo.Matrix{{2, 1}, {1, 1}, {-1, 4}},
+			expectedResult: numericalgo.Matrix{{1.5, 1}, {0, 4}},
+			expectedError:  nil,
+		},
+		"mismatched dimensions": {
+			matrix:         numericalgo.Matrix{{1, 0}, {0, 1}},
+			b:              numericalgo.Matrix{{1}},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("The number of rows of the 1st matrix must equal the number of rows of the 2nd matrix"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.matrix.LeftDivideNonNegative(c.b)
+			assert.True(t, result.IsSimilar(c.expectedResult, 1e-12))
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestMatrixLeftDivideNonNegativeUnmixing(t *testing.T) {
+	spectra := numericalgo.Matrix{
+		{0.9, 0.1, 0.3},
+		{0.7, 0.2, 0.5},
+		{0.4, 0.6, 0.2},
+		{0.2, 0.9, 0.1},
+		{0.1, 0.4, 0.8},
+	}
+	abundances := numericalgo.Matrix{{0.6}, {0}, {0.4}}
+
+	mixed, err := spectra.MultiplyBy(abundances)
+	assert.Equal(t, nil, err)
+
+	result, err := spectra.LeftDivideNonNegative(mixed)
+	assert.Equal(t, nil, err)
+	assert.True(t, result.IsSimilar(abundances, 1e-12))
+}
+
+func TestMatrixLeftDivideBounded(t *testing.T) {
+	inf := math.Inf(1)
+
+	cases := map[string]struct {
+		matrix         numericalgo.Matrix
+		b              numericalgo.Matrix
+		lower          numericalgo.Vector
+		upper          numericalgo.Vector
+		expectedResult numericalgo.Matrix
+		expectedError  error
+	}{
+		"both bounds active": {
+			matrix:         numericalgo.Matrix{{1, 0}, {0, 1}},
+			b:              numericalgo.Matrix{{2}, {-3}},
+			lower:          numericalgo.Vector{0, -1},
+			upper:          numericalgo.Vector{1, 1},
+			expectedResult: numericalgo.Matrix{{1}, {-1}},
+			expectedError:  nil,
+		},
+		"infinite bounds give least squares": {
+			matrix:         numericalgo.Matrix{{1, 1}, {1, 2}, {1, 3}},
+			b:              numericalgo.Matrix{{3}, {2}, {1}},
+			lower:          numericalgo.Vector{-inf, -inf},
+			upper:          numericalgo.Vector{inf, inf},
+			expectedResult: numericalgo.Matrix{{4}, {-1}},
+			expectedError:  nil,
+		},
+		"bounded slope": {
+			matrix:         numericalgo.Matrix{{1, 1}, {1, 2}, {1, 3}},
+			b:              numericalgo.Matrix{{3}, {2}, {1}},
+			lower:          numericalgo.Vector{-inf, -0.5},
+			upper:          numericalgo.Vector{inf, inf},
+			expectedResult: numericalgo.Matrix{{3}, {-0.5}},
+			expectedError:  nil,
+		},
+		"inverted bounds": {
+			matrix:         numericalgo.Matrix{{1, 0}, {0, 1}},
+			b:              numericalgo.Matrix{{2}, {-3}},
+			lower:          numericalgo.Vector{0, 1},
+			upper:          numericalgo.Vector{1, -1},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Lower bound cannot be greater than upper bound"),
+		},
+		"wrong number of bounds": {
+			matrix:         numericalgo.Matrix{{1, 0}, {0, 1}},
+			b:              numericalgo.Matrix{{2}, {-3}},
+			lower:          numericalgo.Vector{0},
+			upper:          numericalgo.Vector{1},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Bounds must have one element per column of the matrix"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.matrix.LeftDivideBounded(c.b, c.lower, c.upper)
+			assert.True(t, result.IsSimilar(c.expectedResult, 1e-12))
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestMatrixLeftDivideConstrained(t *testing.T) {
+	cases := map[string]struct {
+		matrix         numericalgo.Matrix
+		b              numericalgo.Matrix
+		c              numericalgo.Matrix
+		d              numericalgo.Matrix
+		expectedResult numericalgo.Matrix
+		expectedError  error
+	}{
+		"projection onto a plane": {
+			matrix:         numericalgo.Matrix{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}},
+			b:              numericalgo.Matrix{{1}, {2}, {3}},
+			c:              numericalgo.Matrix{{1, 1, 1}},
+			d:              numericalgo.Matrix{{1}},
+			expectedResult: numericalgo.Matrix{{-2.0 / 3}, {1.0 / 3}, {4.0 / 3}},
+			expectedError:  nil,
+		},
+		"line fit through a fixed point": {
+			matrix:         numericalgo.Matrix{{1, 1}, {1, 2}, {1, 3}},
+			b:              numericalgo.Matrix{{3}, {2}, {1}},
+			c:              numericalgo.Matrix{{1, 0}},
+			d:              numericalgo.Matrix{{5}},
+			expectedResult: numericalgo.Matrix{{5}, {-10.0 / 7}},
+			expectedError:  nil,
+		},
+		"fully determined by constraints": {
+			matrix:         numericalgo.Matrix{{1, 1}, {1, 2}, {1, 3}},
+			b:              numericalgo.Matrix{{3}, {2}, {1}},
+			c:              numericalgo.Matrix{{1, 0}, {0, 1}},
+			d:              numericalgo.Matrix{{1}, {2}},
+			expectedResult: numericalgo.Matrix{{1}, {2}},
+			expectedError:  nil,
+		},
+		"inconsistent constraints": {
+			matrix:         numericalgo.Matrix{{1, 0}, {0, 1}},
+			b:              numericalgo.Matrix{{1}, {2}},
+			c:              numericalgo.Matrix{{1, 1}, {2, 2}},
+			d:              numericalgo.Matrix{{1}, {3}},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Equality constraints are inconsistent"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.matrix.LeftDivideConstrained(c.b, c.c, c.d)
+			assert.True(t, result.IsSimilar(c.expectedResult, 1e-12))
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
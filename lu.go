@@ -0,0 +1,192 @@
+package numericalgo
+
+import (
+	"fmt"
+	"math"
+)
+
+// LU holds the LU decomposition of a square matrix with partial pivoting, such that P*A = L*U.
+// L (unit lower triangular) and U (upper triangular) are stored together in a single matrix.
+type LU struct {
+	lu     Matrix
+	pivot  []int
+	single bool
+}
+
+// LU returns the LU decomposition of the square matrix computed with Gaussian elimination and partial pivoting,
+// and the error (if there is any). The original matrix is left unchanged, so the decomposition can be reused for
+// many right-hand sides.
+func (m Matrix) LU() (*LU, error) {
+	return m.factorizeLU(false)
+}
+
+// LUFloat32 returns the LU decomposition of the square matrix computed entirely in float32 arithmetic, and the
+// error (if there is any). Solving with the resulting decomposition is performed in float32 as well, which makes it
+// suitable as the cheap inner solver for the mixed precision path of SolveRefined.
+func (m Matrix) LUFloat32() (*LU, error) {
+	return m.factorizeLU(true)
+}
+
+func (m Matrix) factorizeLU(single bool) (*LU, error) {
+	if m.isNil() {
+		return nil, fmt.Errorf("Matrix cannot be nil")
+	}
+
+	if !m.isSquare() {
+		return nil, fmt.Errorf("Cannot factorize non-square Matrix")
+	}
+
+	n, _ := m.Dim()
+	lu := m.copy()
+	pivot := make([]int, n)
+	for i := range pivot {
+		pivot[i] = i
+	}
+
+	if single {
+		for i := range lu {
+			for j := range lu[i] {
+				lu[i][j] = float64(float32(lu[i][j]))
+			}
+		}
+	}
+
+	tol := float64(n) * machineEpsilon(single) * lu.maxAbs()
+
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(lu[i][k]) > math.Abs(lu[p][k]) {
+				p = i
+			}
+		}
+
+		if math.Abs(lu[p][k]) <= tol {
+			return nil, fmt.Errorf("Matrix is singular")
+		}
+
+		if p != k {
+			lu[p], lu[k] = lu[k], lu[p]
+			pivot[p], pivot[k] = pivot[k], pivot[p]
+		}
+
+		for i := k + 1; i < n; i++ {
+			if single {
+				l := float32(lu[i][k]) / float32(lu[k][k])
+				lu[i][k] = float64(l)
+				for j := k + 1; j < n; j++ {
+					lu[i][j] = float64(float32(lu[i][j]) - l*float32(lu[k][j]))
+				}
+				continue
+			}
+
+			lu[i][k] /= lu[k][k]
+			for j := k + 1; j < n; j++ {
+				lu[i][j] -= lu[i][k] * lu[k][j]
+			}
+		}
+	}
+
+	return &LU{lu: lu, pivot: pivot, single: single}, nil
+}
+
+// Solve receives the right-hand side vector b. It solves A*x = b using the decomposition and returns x and the
+// error (if there is any).
+func (f *LU) Solve(b Vector) (Vector, error) {
+	n := len(f.lu)
+	if b.Dim() != n {
+		return nil, fmt.Errorf("Dimensions must match")
+	}
+
+	if f.single {
+		return f.solveSingle(b), nil
+	}
+
+	x := make(Vector, n)
+	for i := range x {
+		x[i] = b[f.pivot[i]]
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] -= f.lu[i][j] * x[j]
+		}
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= f.lu[i][j] * x[j]
+		}
+		x[i] /= f.lu[i][i]
+	}
+
+	return x, nil
+}
+
+func (f *LU) solveSingle(b Vector) Vector {
+	n := len(f.lu)
+	x := make([]float32, n)
+	for i := range x {
+		x[i] = float32(b[f.pivot[i]])
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] -= float32(f.lu[i][j]) * x[j]
+		}
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= float32(f.lu[i][j]) * x[j]
+		}
+		x[i] /= float32(f.lu[i][i])
+	}
+
+	r := make(Vector, n)
+	for i := range x {
+		r[i] = float64(x[i])
+	}
+	return r
+}
+
+// L returns the unit lower triangular factor of the decomposition.
+func (f *LU) L() Matrix {
+	n := len(f.lu)
+	l := make(Matrix, n)
+	for i := range l {
+		l[i] = make(Vector, n)
+		for j := 0; j < i; j++ {
+			l[i][j] = f.lu[i][j]
+		}
+		l[i][i] = 1
+	}
+	return l
+}
+
+// U returns the upper triangular factor of the decomposition.
+func (f *LU) U() Matrix {
+	n := len(f.lu)
+	u := make(Matrix, n)
+	for i := range u {
+		u[i] = make(Vector, n)
+		for j := i; j < n; j++ {
+			u[i][j] = f.lu[i][j]
+		}
+	}
+	return u
+}
+
+// Pivot returns the row permutation of the decomposition. Row i of P*A is row Pivot()[i] of A.
+func (f *LU) Pivot() []int {
+	p := make([]int, len(f.pivot))
+	copy(p, f.pivot)
+	return p
+}
+
+func machineEpsilon(single bool) float64 {
+	if single {
+		return float64(math.Nextafter32(1, 2) - 1)
+	}
+	return math.Nextafter(1, 2) - 1
+}
@@ -0,0 +1,104 @@
+package numericalgo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLUSolve(t *testing.T) {
+	cases := map[string]struct {
+		matrix         numericalgo.Matrix
+		b              numericalgo.Vector
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"basic 3x3 system": {
+			matrix: numericalgo.Matrix{
+				{2, 1, -1},
+				{-3, -1, 2},
+				{-2, 1, 2},
+			},
+			b:              numericalgo.Vector{8, -11, -3},
+			expectedResult: numericalgo.Vector{2, 3, -1},
+			expectedError:  nil,
+		},
+		"system which requires pivoting": {
+			matrix: numericalgo.Matrix{
+				{0, 1},
+				{1, 1},
+			},
+			b:              numericalgo.Vector{2, 3},
+			expectedResult: numericalgo.Vector{1, 2},
+			expectedError:  nil,
+		},
+		"singular matrix": {
+			matrix: numericalgo.Matrix{
+				{1, 2},
+				{2, 4},
+			},
+			b:              numericalgo.Vector{1, 2},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Matrix is singular"),
+		},
+		"non-square matrix": {
+			matrix: numericalgo.Matrix{
+				{1, 2, 3},
+				{4, 5, 6},
+			},
+			b:              numericalgo.Vector{1, 2},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Cannot factorize non-square Matrix"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var result numericalgo.Vector
+			lu, err := c.matrix.LU()
+			if err == nil {
+				result, err = lu.Solve(c.b)
+			}
+			assert.True(t, result.IsSimilar(c.expectedResult, 1e-12))
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestLUFactors(t *testing.T) {
+	m := numericalgo.Matrix{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 10},
+	}
+
+	lu, err := m.LU()
+	assert.Equal(t, nil, err)
+
+	product, err := lu.L().MultiplyBy(lu.U())
+	assert.Equal(t, nil, err)
+
+	var permuted numericalgo.Matrix
+	for _, p := range lu.Pivot() {
+		permuted = append(permuted, m[p])
+	}
+	assert.True(t, product.IsSimilar(permuted, 1e-12))
+}
+
+func TestLUFloat32Solve(t *testing.T) {
+	m := numericalgo.Matrix{
+		{4, 1, 0},
+		{1, 4, 1},
+		{0, 1, 4},
+	}
+	b := numericalgo.Vector{5, 6, 5}
+
+	lu, err := m.LUFloat32()
+	assert.Equal(t, nil, err)
+
+	result, err := lu.Solve(b)
+	assert.Equal(t, nil, err)
+	assert.True(t, result.IsSimilar(numericalgo.Vector{1, 1, 1}, 1e-6))
+}
@@ -159,6 +159,25 @@ func (m Matrix) sumAbs() float64 {
 	return sum
 }
 
+func (m Matrix) maxAbs() float64 {
+	var r float64
+	for i := range m {
+		for j := range m[i] {
+			r = math.Max(r, math.Abs(m[i][j]))
+		}
+	}
+	return r
+}
+
+func (m Matrix) copy() Matrix {
+	r := make(Matrix, len(m))
+	for i := range m {
+		r[i] = make(Vector, len(m[i]))
+		copy(r[i], m[i])
+	}
+	return r
+}
+
 func (m Matrix) isSquare() bool {
 	rows, cols := m.Dim()
 	return rows == cols
@@ -0,0 +1,195 @@
+package pca
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+)
+
+// PCA performs principal component analysis of a data matrix whose rows are observations and columns are
+// variables. The data are centered, and optionally scaled to unit variance, before the principal components are
+// computed from the singular value decomposition of the data, which avoids forming the covariance matrix.
+//
+// Components holds the principal axes as columns, ordered by decreasing explained variance. The sign of every
+// component is chosen so that its largest element in magnitude is positive, which makes the result deterministic.
+type PCA struct {
+	Components             numericalgo.Matrix
+	ExplainedVariance      numericalgo.Vector
+	ExplainedVarianceRatio numericalgo.Vector
+	Scores                 numericalgo.Matrix
+	Mean                   numericalgo.Vector
+	Scale                  numericalgo.Vector
+	nComponents            int
+	scale                  bool
+}
+
+// New receives the number of components to keep (the smaller of the number of observations and variables if it is
+// not positive) and whether the variables are scaled to unit variance, and returns the pointer to the new PCA type.
+// Scaling should be used when the variables are measured in different units.
+func New(nComponents int, scale bool) *PCA {
+	p := &PCA{nComponents: nComponents, scale: scale}
+	return p
+}
+
+// Fit receives the data matrix, computes the principal components and stores them together with the explained
+// variances and the scores of the data. It returns the error if something went wrong.
+func (p *PCA) Fit(x numericalgo.Matrix) error {
+	rows, cols := x.Dim()
+	if rows < 2 || cols == 0 {
+		return fmt.Errorf("Data must have at least two observations")
+	}
+
+	k := p.nComponents
+	if k <= 0 {
+		k = cols
+		if rows < cols {
+			k = rows
+		}
+	}
+	if k > cols || k > rows {
+		return fmt.Errorf("Number of components cannot exceed the number of observations or variables")
+	}
+
+	mean := make(numericalgo.Vector, cols)
+	scale := make(numericalgo.Vector, cols)
+	for j := range mean {
+		col, err := x.Col(j)
+		if err != nil {
+			return err
+		}
+
+		mean[j], err = stats.Mean(col)
+		if err != nil {
+			return err
+		}
+
+		scale[j] = 1
+		if p.scale {
+			sd, err := stats.StdDev(col, 1)
+			if err != nil {
+				return err
+			}
+			// Constant variables have nothing to scale and are left as they are.
+			if sd > 0 {
+				scale[j] = sd
+			}
+		}
+	}
+
+	centered := make(numericalgo.Matrix, rows)
+	for i := range x {
+		centered[i] = make(numericalgo.Vector, cols)
+		for j := range x[i] {
+			centered[i][j] = (x[i][j] - mean[j]) / scale[j]
+		}
+	}
+
+	svd, err := centered.SVD()
+	if err != nil {
+		return err
+	}
+
+	s := svd.S()
+	v := svd.V()
+
+	var total float64
+	for _, val := range s {
+		total += val * val
+	}
+
+	components := make(numericalgo.Matrix, cols)
+	for i := range components {
+		components[i] = make(numericalgo.Vector, k)
+	}
+	variance := make(numericalgo.Vector, k)
+	ratio := make(numericalgo.Vector, k)
+
+	for c := 0; c < k; c++ {
+		sign, largest := 1.0, 0.0
+		for i := 0; i < cols; i++ {
+			if math.Abs(v[i][c]) > largest {
+				largest = math.Abs(v[i][c])
+				sign = math.Copysign(1, v[i][c])
+			}
+		}
+		for i := 0; i < cols; i++ {
+			components[i][c] = sign * v[i][c]
+		}
+
+		variance[c] = s[c] * s[c] / float64(rows-1)
+		if total > 0 {
+			ratio[c] = s[c] * s[c] / total
+		}
+	}
+
+	p.Mean = mean
+	p.Scale = nil
+	if p.scale {
+		p.Scale = scale
+	}
+	p.Components = components
+	p.ExplainedVariance = variance
+	p.ExplainedVarianceRatio = ratio
+	p.Scores, err = p.Transform(x)
+	return err
+}
+
+// Transform receives a data matrix with the same variables as the fitted data. It returns the scores of the
+// observations, their coordinates along the principal components, and the error (if there is any).
+func (p *PCA) Transform(x numericalgo.Matrix) (numericalgo.Matrix, error) {
+	if p.Components == nil {
+		return nil, fmt.Errorf("PCA has not been fitted")
+	}
+
+	cols, k := p.Components.Dim()
+	r := make(numericalgo.Matrix, len(x))
+	for i := range x {
+		if len(x[i]) != cols {
+			return nil, fmt.Errorf("Number of variables must match the fitted data")
+		}
+
+		r[i] = make(numericalgo.Vector, k)
+		for j := range x[i] {
+			z := x[i][j] - p.Mean[j]
+			if p.Scale != nil {
+				z /= p.Scale[j]
+			}
+			for c := 0; c < k; c++ {
+				r[i][c] += z * p.Components[j][c]
+			}
+		}
+	}
+	return r, nil
+}
+
+// InverseTransform receives the scores of observations. It returns the observations mapped back to the original
+// variables, and the error (if there is any). When not all components are kept, the result is the projection of
+// the original data onto the subspace spanned by the kept components.
+func (p *PCA) InverseTransform(scores numericalgo.Matrix) (numericalgo.Matrix, error) {
+	if p.Components == nil {
+		return nil, fmt.Errorf("PCA has not been fitted")
+	}
+
+	cols, k := p.Components.Dim()
+	r := make(numericalgo.Matrix, len(scores))
+	for i := range scores {
+		if len(scores[i]) != k {
+			return nil, fmt.Errorf("Number of scores must match the number of components")
+		}
+
+		r[i] = make(numericalgo.Vector, cols)
+		for j := range r[i] {
+			var z float64
+			for c := 0; c < k; c++ {
+				z += scores[i][c] * p.Components[j][c]
+			}
+			if p.Scale != nil {
+				z *= p.Scale[j]
+			}
+			r[i][j] = z + p.Mean[j]
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,194 @@
+package pca_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/pca"
+	"github.com/stretchr/testify/assert"
+)
+
+var data = numericalgo.Matrix{
+	{2.5, 2.4},
+	{0.5, 0.7},
+	{2.2, 2.9},
+	{1.9, 2.2},
+	{3.1, 3.0},
+	{2.3, 2.7},
+	{2, 1.6},
+	{1, 1.1},
+	{1.5, 1.6},
+	{1.1, 0.9},
+}
+
+func TestPCAFit(t *testing.T) {
+	p := pca.New(0, false)
+	err := p.Fit(data)
+	assert.Nil(t, err)
+
+	assert.InEpsilon(t, 1.2840277121727839, p.ExplainedVariance[0], 1e-13)
+	assert.InEpsilon(t, 0.04908339893832736, p.ExplainedVariance[1], 1e-12)
+	assert.InEpsilon(t, 0.963181314348646, p.ExplainedVarianceRatio[0], 1e-13)
+	assert.InEpsilon(t, 1, p.ExplainedVarianceRatio[0]+p.ExplainedVarianceRatio[1], 1e-14)
+
+	assert.InEpsilon(t, 0.6778733985280117, p.Components[0][0], 1e-13)
+	assert.InEpsilon(t, 0.735178655544408, p.Components[1][0], 1e-13)
+	assert.InEpsilon(t, 0.827970186201088, p.Scores[0][0], 1e-13)
+
+	assert.True(t, p.Mean.IsSimilar(numericalgo.Vector{1.81, 1.91}, 1e-14))
+	assert.Nil(t, p.Scale)
+}
+
+func TestPCAInverseTransform(t *testing.T) {
+	cases := map[string]struct {
+		nComponents int
+		scale       bool
+		exact       bool
+	}{
+		"all components": {
+			nComponents: 0,
+			scale:       false,
+			exact:       true,
+		},
+		"all components scaled": {
+			nComponents: 2,
+			scale:       true,
+			exact:       true,
+		},
+		"first component": {
+			nComponents: 1,
+			scale:       false,
+			exact:       false,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := pca.New(c.nComponents, c.scale)
+			assert.Nil(t, p.Fit(data))
+
+			back, err := p.InverseTransform(p.Scores)
+			assert.Nil(t, err)
+
+			var maxErr float64
+			for i := range data {
+				for j := range data[i] {
+					maxErr = math.Max(maxErr, math.Abs(data[i][j]-back[i][j]))
+				}
+			}
+
+			if c.exact {
+				assert.InDelta(t, 0, maxErr, 1e-14)
+			} else {
+				// Dropping the second component loses only its share of the variance.
+				assert.True(t, maxErr > 0.01 && maxErr < 0.5)
+			}
+		})
+	}
+}
+
+func TestPCAScaled(t *testing.T) {
+	// Scaling makes the result independent of the units of the variables.
+	rescaled := make(numericalgo.Matrix, len(data))
+	for i := range data {
+		rescaled[i] = numericalgo.Vector{1000 * data[i][0], data[i][1]}
+	}
+
+	a := pca.New(0, true)
+	assert.Nil(t, a.Fit(data))
+	b := pca.New(0, true)
+	assert.Nil(t, b.Fit(rescaled))
+
+	assert.True(t, a.ExplainedVarianceRatio.IsSimilar(b.ExplainedVarianceRatio, 1e-12))
+	for i := range a.Scores {
+		assert.True(t, a.Scores[i].IsSimilar(b.Scores[i], 1e-12))
+	}
+}
+
+func TestPCATransform(t *testing.T) {
+	p := pca.New(1, false)
+	assert.Nil(t, p.Fit(data))
+
+	scores, err := p.Transform(numericalgo.Matrix{{1.81, 1.91}, {2.5, 2.4}})
+	assert.Nil(t, err)
+	assert.InDelta(t, 0, scores[0][0], 1e-15)
+	assert.InEpsilon(t, 0.827970186201088, scores[1][0], 1e-13)
+	assert.Equal(t, 1, len(scores[0]))
+}
+
+func TestPCAWideData(t *testing.T) {
+	// Five observations of twenty variables, generated from two latent factors.
+	wide := make(numericalgo.Matrix, 5)
+	for i := range wide {
+		wide[i] = make(numericalgo.Vector, 20)
+		a, b := float64(i)-2, math.Sin(float64(3*i))
+		for j := range wide[i] {
+			wide[i][j] = a*float64(j%4+1) + b*math.Cos(float64(j)) + float64(j)
+		}
+	}
+
+	cases := map[string]struct {
+		nComponents        int
+		expectedComponents int
+		expectedError      error
+	}{
+		"default keeps as many components as observations": {
+			nComponents:        0,
+			expectedComponents: 5,
+			expectedError:      nil,
+		},
+		"explicit number of components": {
+			nComponents:        2,
+			expectedComponents: 2,
+			expectedError:      nil,
+		},
+		"more components than observations": {
+			nComponents:        6,
+			expectedComponents: 0,
+			expectedError:      fmt.Errorf("Number of components cannot exceed the number of observations or variables"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := pca.New(c.nComponents, false)
+			err := p.Fit(wide)
+			assert.Equal(t, c.expectedError, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, 20, len(p.Components))
+			assert.Equal(t, c.expectedComponents, len(p.Components[0]))
+			assert.Equal(t, c.expectedComponents, len(p.ExplainedVariance))
+			assert.InEpsilon(t, 1, p.ExplainedVarianceRatio[0]+p.ExplainedVarianceRatio[1], 1e-12)
+
+			back, err := p.InverseTransform(p.Scores)
+			assert.Nil(t, err)
+			for i := range wide {
+				assert.InDeltaSlice(t, wide[i], back[i], 1e-10)
+			}
+		})
+	}
+}
+
+func TestPCAInvalid(t *testing.T) {
+	p := pca.New(0, false)
+	_, err := p.Transform(data)
+	assert.Equal(t, fmt.Errorf("PCA has not been fitted"), err)
+
+	err = pca.New(3, false).Fit(data)
+	assert.Equal(t, fmt.Errorf("Number of components cannot exceed the number of observations or variables"), err)
+
+	err = p.Fit(numericalgo.Matrix{{1, 2}})
+	assert.Equal(t, fmt.Errorf("Data must have at least two observations"), err)
+
+	assert.Nil(t, p.Fit(data))
+	_, err = p.Transform(numericalgo.Matrix{{1}})
+	assert.Equal(t, fmt.Errorf("Number of variables must match the fitted data"), err)
+
+	_, err = p.InverseTransform(numericalgo.Matrix{{1}})
+	assert.Equal(t, fmt.Errorf("Number of scores must match the number of components"), err)
+}
@@ -0,0 +1,264 @@
+package numericalgo
+
+import (
+	"fmt"
+	"math"
+)
+
+// QR holds the QR decomposition of a matrix computed with Householder reflections, such that A*P = Q*R. P is the
+// identity unless the decomposition was computed with column pivoting. The Householder vectors are stored below the
+// diagonal of qr, and the diagonal of R is stored separately.
+type QR struct {
+	qr    Matrix
+	rDiag Vector
+	perm  []int
+}
+
+// QR returns the QR decomposition of the matrix and the error (if there is any). The matrix must have at least as
+// many rows as columns. The original matrix is left unchanged, so the decomposition can be reused for many
+// right-hand sides.
+func (m Matrix) QR() (*QR, error) {
+	if m.isNil() {
+		return nil, fmt.Errorf("Matrix cannot be nil")
+	}
+
+	rows, cols := m.Dim()
+	if rows < cols {
+		return nil, fmt.Errorf("Matrix must have at least as many rows as columns")
+	}
+
+	return m.householder(false), nil
+}
+
+// QRPivot returns the rank-revealing QR decomposition of the matrix with column pivoting, and the error (if there
+// is any). At every step the remaining column with the largest norm is moved to the front, so the diagonal of R is
+// non-increasing in magnitude and the numerical rank can be read from it. The matrix may have any shape.
+func (m Matrix) QRPivot() (*QR, error) {
+	if m.isNil() {
+		return nil, fmt.Errorf("Matrix cannot be nil")
+	}
+
+	return m.householder(true), nil
+}
+
+func (m Matrix) householder(pivot bool) *QR {
+	rows, cols := m.Dim()
+	steps := rows
+	if cols < steps {
+		steps = cols
+	}
+
+	qr := m.copy()
+	rDiag := make(Vector, steps)
+
+	var perm []int
+	if pivot {
+		perm = make([]int, cols)
+		for j := range perm {
+			perm[j] = j
+		}
+	}
+
+	for k := 0; k < steps; k++ {
+		if pivot {
+			p, pNorm := k, -1.0
+			for j := k; j < cols; j++ {
+				var nrm float64
+				for i := k; i < rows; i++ {
+					nrm = math.Hypot(nrm, qr[i][j])
+				}
+				if nrm > pNorm {
+					p, pNorm = j, nrm
+				}
+			}
+
+			if p != k {
+				for i := range qr {
+					qr[i][p], qr[i][k] = qr[i][k], qr[i][p]
+				}
+				perm[p], perm[k] = perm[k], perm[p]
+			}
+		}
+
+		var nrm float64
+		for i := k; i < rows; i++ {
+			nrm = math.Hypot(nrm, qr[i][k])
+		}
+
+		if nrm != 0 {
+			if qr[k][k] < 0 {
+				nrm = -nrm
+			}
+			for i := k; i < rows; i++ {
+				qr[i][k] /= nrm
+			}
+			qr[k][k]++
+
+			for j := k + 1; j < cols; j++ {
+				var s float64
+				for i := k; i < rows; i++ {
+					s += qr[i][k] * qr[i][j]
+				}
+				s = -s / qr[k][k]
+				for i := k; i < rows; i++ {
+					qr[i][j] += s * qr[i][k]
+				}
+			}
+		}
+		rDiag[k] = -nrm
+	}
+
+	return &QR{qr: qr, rDiag: rDiag, perm: perm}
+}
+
+// IsFullRank returns true if R, and thus the decomposed matrix, has full column rank.
+func (f *QR) IsFullRank() bool {
+	_, cols := f.qr.Dim()
+	return f.Rank(0) == cols
+}
+
+// Rank receives the tolerance as a parameter and returns the numerical rank of the decomposed matrix, which is the
+// number of diagonal elements of R larger than the tolerance in magnitude. If the tolerance is not positive, the
+// default max(rows, cols) * eps * max|R(i, i)| is used. The rank is only reliable for decompositions computed with
+// QRPivot.
+func (f *QR) Rank(tol float64) int {
+	rows, cols := f.qr.Dim()
+	if tol <= 0 {
+		tol = float64(rows) * machineEpsilon(false) * f.rDiag.maxAbs()
+		if cols > rows {
+			tol = float64(cols) * machineEpsilon(false) * f.rDiag.maxAbs()
+		}
+	}
+
+	var r int
+	for _, d := range f.rDiag {
+		if math.Abs(d) > tol {
+			r++
+		}
+	}
+	return r
+}
+
+// Perm returns the column permutation of the decomposition. Column j of A*P is column Perm()[j] of A.
+func (f *QR) Perm() []int {
+	_, cols := f.qr.Dim()
+	p := make([]int, cols)
+	for j := range p {
+		p[j] = j
+	}
+	copy(p, f.perm)
+	return p
+}
+
+// Solve receives the right-hand side vector b. It returns the least squares solution x minimizing ||A*x - b||, and
+// the error (if there is any). For square matrices this is the exact solution of A*x = b. Decompositions computed
+// with QR require full column rank. For decompositions computed with QRPivot of a rank-deficient matrix, Solve
+// returns the basic solution, which has at most Rank(0) non-zero elements.
+func (f *QR) Solve(b Vector) (Vector, error) {
+	rows, cols := f.qr.Dim()
+	if b.Dim() != rows {
+		return nil, fmt.Errorf("Dimensions must match")
+	}
+
+	rank := f.Rank(0)
+	if f.perm == nil && rank != cols {
+		return nil, fmt.Errorf("Matrix is rank deficient")
+	}
+
+	y := f.applyQT(b)
+
+	z := make(Vector, cols)
+	copy(z, y[:rank])
+	for i := rank - 1; i >= 0; i-- {
+		for j := i + 1; j < rank; j++ {
+			z[i] -= f.qr[i][j] * z[j]
+		}
+		z[i] /= f.rDiag[i]
+	}
+
+	if f.perm == nil {
+		return z, nil
+	}
+
+	x := make(Vector, cols)
+	for j, p := range f.perm {
+		x[p] = z[j]
+	}
+	return x, nil
+}
+
+// applyQT returns Q'*b.
+func (f *QR) applyQT(b Vector) Vector {
+	rows := len(f.qr)
+	y := make(Vector, rows)
+	copy(y, b)
+
+	for k := range f.rDiag {
+		if f.rDiag[k] == 0 {
+			continue
+		}
+		var s float64
+		for i := k; i < rows; i++ {
+			s += f.qr[i][k] * y[i]
+		}
+		s = -s / f.qr[k][k]
+		for i := k; i < rows; i++ {
+			y[i] += s * f.qr[i][k]
+		}
+	}
+	return y
+}
+
+// Q returns the economy-size orthogonal factor, whose min(rows, cols) columns are orthonormal.
+func (f *QR) Q() Matrix {
+	return f.buildQ(len(f.rDiag))
+}
+
+// FullQ returns the square orthogonal factor. Its columns beyond the rank of A span the orthogonal complement of
+// the column space of A.
+func (f *QR) FullQ() Matrix {
+	return f.buildQ(len(f.qr))
+}
+
+func (f *QR) buildQ(cols int) Matrix {
+	rows := len(f.qr)
+	q := make(Matrix, rows)
+	for i := range q {
+		q[i] = make(Vector, cols)
+	}
+	for j := 0; j < cols; j++ {
+		q[j][j] = 1
+	}
+
+	for k := len(f.rDiag) - 1; k >= 0; k-- {
+		if f.rDiag[k] == 0 {
+			continue
+		}
+		for j := k; j < cols; j++ {
+			var s float64
+			for i := k; i < rows; i++ {
+				s += f.qr[i][k] * q[i][j]
+			}
+			s = -s / f.qr[k][k]
+			for i := k; i < rows; i++ {
+				q[i][j] += s * f.qr[i][k]
+			}
+		}
+	}
+	return q
+}
+
+// R returns the upper triangular (or upper trapezoidal, for matrices with more columns than rows) factor of the
+// decomposition.
+func (f *QR) R() Matrix {
+	_, cols := f.qr.Dim()
+	r := make(Matrix, len(f.rDiag))
+	for i := range r {
+		r[i] = make(Vector, cols)
+		r[i][i] = f.rDiag[i]
+		for j := i + 1; j < cols; j++ {
+			r[i][j] = f.qr[i][j]
+		}
+	}
+	return r
+}
@@ -0,0 +1,177 @@
+package numericalgo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQRSolve(t *testing.T) {
+	cases := map[string]struct {
+		matrix         numericalgo.Matrix
+		b              numericalgo.Vector
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"square system": {
+			matrix: numericalgo.Matrix{
+				{2, 1, -1},
+				{-3, -1, 2},
+				{-2, 1, 2},
+			},
+			b:              numericalgo.Vector{8, -11, -3},
+			expectedResult: numericalgo.Vector{2, 3, -1},
+			expectedError:  nil,
+		},
+		"overdetermined line fit": {
+			matrix: numericalgo.Matrix{
+				{1, 1.3},
+				{1, 2.1},
+				{1, 3.7},
+				{1, 4.2},
+			},
+			b:              numericalgo.Vector{2.2, 5.8, 10.2, 11.8},
+			expectedResult: numericalgo.Vector{-1.5225601452564678, 3.193826600090785},
+			expectedError:  nil,
+		},
+		"rank deficient matrix": {
+			matrix: numericalgo.Matrix{
+				{1, 2},
+				{2, 4},
+				{3, 6},
+			},
+			b:              numericalgo.Vector{1, 2, 3},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Matrix is rank deficient"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			qr, err := c.matrix.QR()
+			assert.Equal(t, nil, err)
+			result, err := qr.Solve(c.b)
+			assert.True(t, result.IsSimilar(c.expectedResult, 1e-12))
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestQRFactors(t *testing.T) {
+	m := numericalgo.Matrix{
+		{12, -51, 4},
+		{6, 167, -68},
+		{-4, 24, -41},
+		{1, 1, 1},
+	}
+
+	qr, err := m.QR()
+	assert.Equal(t, nil, err)
+
+	q := qr.Q()
+	product, err := q.MultiplyBy(qr.R())
+	assert.Equal(t, nil, err)
+	assert.True(t, product.IsSimilar(m, 1e-12))
+
+	qT, err := q.Transpose()
+	assert.Equal(t, nil, err)
+	qTq, err := qT.MultiplyBy(q)
+	assert.Equal(t, nil, err)
+	assert.True(t, qTq.IsSimilar(numericalgo.Matrix{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}, 1e-12))
+}
+
+func TestQRWideMatrix(t *testing.T) {
+	_, err := numericalgo.Matrix{{1, 2, 3}}.QR()
+	assert.Equal(t, fmt.Errorf("Matrix must have at least as many rows as columns"), err)
+}
+
+func TestQRPivot(t *testing.T) {
+	cases := map[string]struct {
+		matrix       numericalgo.Matrix
+		expectedRank int
+	}{
+		"full rank matrix": {
+			matrix: numericalgo.Matrix{
+				{1, 2},
+				{3, 4},
+				{5, 7},
+			},
+			expectedRank: 2,
+		},
+		"collinear columns": {
+			matrix: numericalgo.Matrix{
+				{1, 2, 0},
+				{2, 4, 1},
+				{3, 6, 0},
+				{4, 8, 1},
+			},
+			expectedRank: 2,
+		},
+		"wide matrix": {
+			matrix: numericalgo.Matrix{
+				{1, 2, 3, 4},
+				{2, 4, 6, 8},
+			},
+			expectedRank: 1,
+		},
+		"zero matrix": {
+			matrix: numericalgo.Matrix{
+				{0, 0},
+				{0, 0},
+			},
+			expectedRank: 0,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			qr, err := c.matrix.QRPivot()
+			assert.Equal(t, nil, err)
+			assert.Equal(t, c.expectedRank, qr.Rank(0))
+
+			var permuted numericalgo.Matrix
+			for _, row := range c.matrix {
+				var r numericalgo.Vector
+				for _, p := range qr.Perm() {
+					r = append(r, row[p])
+				}
+				permuted = append(permuted, r)
+			}
+
+			product, err := qr.Q().MultiplyBy(qr.R())
+			assert.Equal(t, nil, err)
+			assert.True(t, product.IsSimilar(permuted, 1e-12))
+		})
+	}
+}
+
+func TestQRPivotBasicSolution(t *testing.T) {
+	m := numericalgo.Matrix{
+		{1, 2, 0},
+		{2, 4, 1},
+		{3, 6, 0},
+		{4, 8, 1},
+	}
+	b := numericalgo.Vector{2, 5, 6, 9}
+
+	qr, err := m.QRPivot()
+	assert.Equal(t, nil, err)
+
+	x, err := qr.Solve(b)
+	assert.Equal(t, nil, err)
+
+	var zeros int
+	for _, val := range x {
+		if val == 0 {
+			zeros++
+		}
+	}
+	assert.Equal(t, 1, zeros)
+
+	for i, row := range m {
+		fitted, _ := row.Dot(x)
+		assert.InDelta(t, b[i], fitted, 1e-12)
+	}
+}
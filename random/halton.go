@@ -0,0 +1,85 @@
+package random
+
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Halton generates the low-discrepancy Halton sequence in the unit cube [0, 1)^dim. Coordinate j of the i-th point
+// is the radical inverse of i in the j-th prime base. The sequence starts with the origin.
+//
+// Coordinates in neighbouring large prime bases are strongly correlated for short sequences, so the Sobol sequence
+// is usually the better choice in more than about ten dimensions.
+type Halton struct {
+	bases []int
+	index uint64
+}
+
+// NewHalton receives the dimension and returns the pointer to the new Halton sequence and the error (if there is
+// any).
+func NewHalton(dim int) (*Halton, error) {
+	if dim < 1 {
+		return nil, fmt.Errorf("Dimension must be positive")
+	}
+	return &Halton{bases: primes(dim)}, nil
+}
+
+// Next returns the next point of the sequence.
+func (h *Halton) Next() numericalgo.Vector {
+	p := make(numericalgo.Vector, len(h.bases))
+	for j, b := range h.bases {
+		p[j] = radicalInverse(h.index, b)
+	}
+	h.index++
+	return p
+}
+
+// Skip receives the number of points and advances the sequence past them.
+func (h *Halton) Skip(n int) {
+	h.index += uint64(n)
+}
+
+// Points receives the number of points and returns the next n points of the sequence as the rows of a matrix.
+func (h *Halton) Points(n int) numericalgo.Matrix {
+	m := make(numericalgo.Matrix, n)
+	for i := range m {
+		m[i] = h.Next()
+	}
+	return m
+}
+
+// radicalInverse mirrors the digits of i in the given base around the radix point.
+func radicalInverse(i uint64, base int) float64 {
+	b := uint64(base)
+	inv := 1 / float64(base)
+	f := inv
+	var r float64
+	for i > 0 {
+		r += float64(i%b) * f
+		i /= b
+		f *= inv
+	}
+	return r
+}
+
+// primes returns the first n prime numbers.
+func primes(n int) []int {
+	p := make([]int, 0, n)
+	for c := 2; len(p) < n; c++ {
+		isPrime := true
+		for _, q := range p {
+			if q*q > c {
+				break
+			}
+			if c%q == 0 {
+				isPrime = false
+				break
+			}
+		}
+		if isPrime {
+			p = append(p, c)
+		}
+	}
+	return p
+}
@@ -0,0 +1,43 @@
+package random_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/random"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHalton(t *testing.T) {
+	h, err := random.NewHalton(3)
+	assert.Nil(t, err)
+
+	expected := numericalgo.Matrix{
+		{0, 0, 0},
+		{1.0 / 2, 1.0 / 3, 1.0 / 5},
+		{1.0 / 4, 2.0 / 3, 2.0 / 5},
+		{3.0 / 4, 1.0 / 9, 3.0 / 5},
+		{1.0 / 8, 4.0 / 9, 4.0 / 5},
+		{5.0 / 8, 7.0 / 9, 1.0 / 25},
+	}
+
+	points := h.Points(6)
+	for i := range expected {
+		assert.InDeltaSlice(t, expected[i], points[i], 1e-15)
+	}
+}
+
+func TestHaltonSkip(t *testing.T) {
+	a, _ := random.NewHalton(4)
+	b, _ := random.NewHalton(4)
+
+	a.Points(100)
+	b.Skip(100)
+	assert.Equal(t, a.Next(), b.Next())
+}
+
+func TestNewHaltonInvalid(t *testing.T) {
+	_, err := random.NewHalton(-2)
+	assert.Equal(t, fmt.Errorf("Dimension must be positive"), err)
+}
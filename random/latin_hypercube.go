@@ -0,0 +1,30 @@
+package random
+
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// LatinHypercube receives the number of samples and the dimension. It returns the Latin hypercube sample from the
+// unit cube [0, 1)^dim as the rows of a matrix, and the error (if there is any). Every coordinate axis is divided
+// into n equal strata and every stratum contains exactly one sample, which spreads the samples more evenly than
+// independent uniform sampling.
+func (r *Random) LatinHypercube(n, dim int) (numericalgo.Matrix, error) {
+	if n < 1 || dim < 1 {
+		return nil, fmt.Errorf("Number of samples and dimension must be positive")
+	}
+
+	m := make(numericalgo.Matrix, n)
+	for i := range m {
+		m[i] = make(numericalgo.Vector, dim)
+	}
+
+	for j := 0; j < dim; j++ {
+		perm := r.rnd.Perm(n)
+		for i, stratum := range perm {
+			m[i][j] = (float64(stratum) + r.rnd.Float64()) / float64(n)
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,38 @@
+package random_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/random"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatinHypercube(t *testing.T) {
+	r := random.New(3)
+	n, dim := 50, 4
+
+	m, err := r.LatinHypercube(n, dim)
+	assert.Nil(t, err)
+	assert.Equal(t, n, len(m))
+
+	for j := 0; j < dim; j++ {
+		seen := make([]bool, n)
+		for i := range m {
+			assert.True(t, m[i][j] >= 0 && m[i][j] < 1)
+			seen[int(m[i][j]*float64(n))] = true
+		}
+		for i := range seen {
+			assert.True(t, seen[i])
+		}
+	}
+
+	m2, _ := random.New(3).LatinHypercube(n, dim)
+	assert.Equal(t, m, m2)
+}
+
+func TestLatinHypercubeInvalid(t *testing.T) {
+	r := random.New(3)
+	_, err := r.LatinHypercube(0, 2)
+	assert.Equal(t, fmt.Errorf("Number of samples and dimension must be positive"), err)
+}
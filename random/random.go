@@ -0,0 +1,68 @@
+package random
+
+import (
+	"math/rand"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Random provides seeded pseudo-random vectors and matrices. Two generators created with the same seed produce the
+// same sequence, which makes Monte Carlo studies reproducible.
+type Random struct {
+	rnd *rand.Rand
+}
+
+// New receives the seed and returns the new Random generator.
+func New(seed int64) *Random {
+	return &Random{rnd: rand.New(rand.NewSource(seed))}
+}
+
+// Float64 returns a uniformly distributed random number from [0, 1).
+func (r *Random) Float64() float64 {
+	return r.rnd.Float64()
+}
+
+// Uniform receives the length and the bounds of the interval. It returns the vector of numbers uniformly
+// distributed in [min, max).
+func (r *Random) Uniform(n int, min, max float64) numericalgo.Vector {
+	v := make(numericalgo.Vector, n)
+	for i := range v {
+		v[i] = min + r.rnd.Float64()*(max-min)
+	}
+	return v
+}
+
+// Normal receives the length, the mean and the standard deviation. It returns the vector of normally distributed
+// numbers.
+func (r *Random) Normal(n int, mu, sigma float64) numericalgo.Vector {
+	v := make(numericalgo.Vector, n)
+	for i := range v {
+		v[i] = mu + sigma*r.rnd.NormFloat64()
+	}
+	return v
+}
+
+// UniformMatrix receives the dimensions and the bounds of the interval. It returns the matrix of numbers uniformly
+// distributed in [min, max).
+func (r *Random) UniformMatrix(rows, cols int, min, max float64) numericalgo.Matrix {
+	m := make(numericalgo.Matrix, rows)
+	for i := range m {
+		m[i] = r.Uniform(cols, min, max)
+	}
+	return m
+}
+
+// NormalMatrix receives the dimensions, the mean and the standard deviation. It returns the matrix of normally
+// distributed numbers.
+func (r *Random) NormalMatrix(rows, cols int, mu, sigma float64) numericalgo.Matrix {
+	m := make(numericalgo.Matrix, rows)
+	for i := range m {
+		m[i] = r.Normal(cols, mu, sigma)
+	}
+	return m
+}
+
+// Perm returns a random permutation of the integers [0, n).
+func (r *Random) Perm(n int) []int {
+	return r.rnd.Perm(n)
+}
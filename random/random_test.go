@@ -0,0 +1,51 @@
+package random_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo/random"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomReproducible(t *testing.T) {
+	a := random.New(7)
+	b := random.New(7)
+
+	assert.Equal(t, a.Uniform(10, -1, 1), b.Uniform(10, -1, 1))
+	assert.Equal(t, a.NormalMatrix(3, 4, 0, 1), b.NormalMatrix(3, 4, 0, 1))
+	assert.Equal(t, a.Perm(10), b.Perm(10))
+
+	c := random.New(8)
+	assert.NotEqual(t, a.Uniform(10, 0, 1), c.Uniform(10, 0, 1))
+}
+
+func TestRandomUniform(t *testing.T) {
+	r := random.New(1)
+	v := r.Uniform(100000, 2, 5)
+
+	var sum float64
+	for _, x := range v {
+		assert.True(t, x >= 2 && x < 5)
+		sum += x
+	}
+	assert.InDelta(t, 3.5, sum/float64(len(v)), 0.02)
+
+	m := r.UniformMatrix(3, 2, 0, 1)
+	assert.Equal(t, 3, len(m))
+	assert.Equal(t, 2, len(m[0]))
+}
+
+func TestRandomNormal(t *testing.T) {
+	r := random.New(1)
+	v := r.Normal(100000, 10, 3)
+
+	var sum, sumSq float64
+	for _, x := range v {
+		sum += x
+		sumSq += x * x
+	}
+	mean := sum / float64(len(v))
+	assert.InDelta(t, 10, mean, 0.05)
+	assert.InDelta(t, 3, math.Sqrt(sumSq/float64(len(v))-mean*mean), 0.05)
+}
@@ -0,0 +1,221 @@
+package random
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// sobolBits is the number of bits of every coordinate, which also bounds the length of the sequence to 2^sobolBits
+// points.
+const sobolBits = 64
+
+// joeKuo holds the primitive polynomials and the initial direction numbers of Joe and Kuo for dimensions 2 to 21.
+// Every entry lists the degree s, the coefficients a of the polynomial between its leading and trailing terms, and
+// the s initial direction numbers m.
+var joeKuo = []struct {
+	s, a uint64
+	m    []uint64
+}{
+	{1, 0, []uint64{1}},
+	{2, 1, []uint64{1, 3}},
+	{3, 1, []uint64{1, 3, 1}},
+	{3, 2, []uint64{1, 1, 1}},
+	{4, 1, []uint64{1, 1, 3, 3}},
+	{4, 4, []uint64{1, 3, 5, 13}},
+	{5, 2, []uint64{1, 1, 5, 5, 17}},
+	{5, 4, []uint64{1, 1, 5, 5, 5}},
+	{5, 7, []uint64{1, 1, 7, 11, 19}},
+	{5, 11, []uint64{1, 1, 5, 1, 1}},
+	{5, 13, []uint64{1, 1, 1, 3, 11}},
+	{5, 14, []uint64{1, 3, 5, 5, 31}},
+	{6, 1, []uint64{1, 3, 3, 9, 7, 49}},
+	{6, 13, []uint64{1, 1, 1, 15, 21, 21}},
+	{6, 16, []uint64{1, 3, 1, 13, 27, 49}},
+	{6, 19, []uint64{1, 1, 1, 15, 7, 5}},
+	{6, 22, []uint64{1, 3, 1, 15, 13, 25}},
+	{6, 25, []uint64{1, 1, 5, 5, 19, 61}},
+	{7, 1, []uint64{1, 3, 7, 11, 23, 15, 103}},
+	{7, 4, []uint64{1, 3, 7, 13, 13, 15, 69}},
+}
+
+// Sobol generates the low-discrepancy Sobol sequence in the unit cube [0, 1)^dim with the Gray code construction of
+// Antonov and Saleev. The sequence starts with the origin, and every block of 2^k consecutive points starting at a
+// multiple of 2^k places exactly one point in every dyadic interval of length 2^-k of every coordinate.
+//
+// The first 21 dimensions use the direction numbers of Joe and Kuo. Higher dimensions use the following primitive
+// polynomials in order with fixed pseudo-random initial direction numbers, which keeps the sequence deterministic
+// and well stratified in every coordinate but without the optimized two-dimensional projections.
+type Sobol struct {
+	v     [][sobolBits]uint64
+	x     []uint64
+	index uint64
+}
+
+// NewSobol receives the dimension and returns the pointer to the new Sobol sequence and the error (if there is any).
+func NewSobol(dim int) (*Sobol, error) {
+	if dim < 1 {
+		return nil, fmt.Errorf("Dimension must be positive")
+	}
+
+	s := &Sobol{v: make([][sobolBits]uint64, dim), x: make([]uint64, dim)}
+
+	// The first coordinate is the van der Corput sequence in base 2.
+	for k := 0; k < sobolBits; k++ {
+		s.v[0][k] = 1 << (sobolBits - 1 - k)
+	}
+
+	poly := uint64(1)
+	for j := 1; j < dim; j++ {
+		var deg, a uint64
+		var m []uint64
+		if j-1 < len(joeKuo) {
+			deg, a, m = joeKuo[j-1].s, joeKuo[j-1].a, joeKuo[j-1].m
+			poly = 1<<deg | a<<1 | 1
+		} else {
+			poly = nextPrimitive(poly)
+			deg = uint64(bits.Len64(poly) - 1)
+			a = (poly >> 1) & (1<<(deg-1) - 1)
+
+			rnd := rand.New(rand.NewSource(int64(j)))
+			m = make([]uint64, deg)
+			for i := range m {
+				m[i] = uint64(rnd.Int63n(1<<uint(i)))<<1 | 1
+			}
+		}
+		s.v[j] = directionNumbers(deg, a, m)
+	}
+
+	return s, nil
+}
+
+// directionNumbers extends the initial direction numbers with the recurrence defined by the primitive polynomial
+// of the given degree and middle coefficients a.
+func directionNumbers(deg, a uint64, m []uint64) [sobolBits]uint64 {
+	var v [sobolBits]uint64
+	for k := 0; k < sobolBits; k++ {
+		var mk uint64
+		if uint64(k) < deg {
+			mk = m[k]
+		} else {
+			mk = m[uint64(k)-deg] ^ m[uint64(k)-deg]<<deg
+			for i := uint64(1); i < deg; i++ {
+				if a>>(deg-1-i)&1 == 1 {
+					mk ^= m[uint64(k)-i] << i
+				}
+			}
+			m = append(m, mk)
+		}
+		v[k] = mk << (sobolBits - 1 - k)
+	}
+	return v
+}
+
+// Next returns the next point of the sequence.
+func (s *Sobol) Next() numericalgo.Vector {
+	p := make(numericalgo.Vector, len(s.x))
+	for j, x := range s.x {
+		p[j] = float64(x>>11) / (1 << 53)
+	}
+
+	c := bits.TrailingZeros64(^s.index)
+	for j := range s.x {
+		s.x[j] ^= s.v[j][c]
+	}
+	s.index++
+	return p
+}
+
+// Skip receives the number of points and advances the sequence past them.
+func (s *Sobol) Skip(n int) {
+	for i := 0; i < n; i++ {
+		c := bits.TrailingZeros64(^s.index)
+		for j := range s.x {
+			s.x[j] ^= s.v[j][c]
+		}
+		s.index++
+	}
+}
+
+// Points receives the number of points and returns the next n points of the sequence as the rows of a matrix.
+func (s *Sobol) Points(n int) numericalgo.Matrix {
+	m := make(numericalgo.Matrix, n)
+	for i := range m {
+		m[i] = s.Next()
+	}
+	return m
+}
+
+// nextPrimitive returns the smallest primitive polynomial over GF(2) greater than p, with polynomials encoded as
+// the bits of their coefficients.
+func nextPrimitive(p uint64) uint64 {
+	for q := p + 1; ; q++ {
+		if q&1 == 1 && isPrimitive(q) {
+			return q
+		}
+	}
+}
+
+// isPrimitive reports whether x generates the multiplicative group of GF(2)[x]/p, i.e. whether the order of x
+// modulo p is 2^deg - 1.
+func isPrimitive(p uint64) bool {
+	deg := uint(bits.Len64(p) - 1)
+	if deg == 0 {
+		return false
+	}
+
+	order := uint64(1)<<deg - 1
+	if polyPowX(order, p) != 1 {
+		return false
+	}
+
+	n := order
+	for q := uint64(2); q*q <= n; q++ {
+		if n%q != 0 {
+			continue
+		}
+		for n%q == 0 {
+			n /= q
+		}
+		if polyPowX(order/q, p) == 1 {
+			return false
+		}
+	}
+	if n > 1 && polyPowX(order/n, p) == 1 {
+		return false
+	}
+	return true
+}
+
+// polyPowX returns x^e modulo the polynomial p over GF(2).
+func polyPowX(e, p uint64) uint64 {
+	r, b := uint64(1), polyMod(2, p)
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			r = polyMulMod(r, b, p)
+		}
+		b = polyMulMod(b, b, p)
+	}
+	return r
+}
+
+func polyMulMod(a, b, p uint64) uint64 {
+	var r uint64
+	for ; b > 0; b >>= 1 {
+		if b&1 == 1 {
+			r ^= a
+		}
+		a = polyMod(a<<1, p)
+	}
+	return polyMod(r, p)
+}
+
+func polyMod(a, p uint64) uint64 {
+	dp := bits.Len64(p)
+	for da := bits.Len64(a); da >= dp; da = bits.Len64(a) {
+		a ^= p << uint(da-dp)
+	}
+	return a
+}
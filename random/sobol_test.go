@@ -0,0 +1,93 @@
+package random_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/random"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSobol(t *testing.T) {
+	s, err := random.NewSobol(3)
+	assert.Nil(t, err)
+
+	expected := numericalgo.Matrix{
+		{0, 0, 0},
+		{0.5, 0.5, 0.5},
+		{0.75, 0.25, 0.25},
+		{0.25, 0.75, 0.75},
+		{0.375, 0.375, 0.625},
+		{0.875, 0.875, 0.125},
+		{0.625, 0.125, 0.875},
+		{0.125, 0.625, 0.375},
+	}
+	assert.Equal(t, expected, s.Points(8))
+}
+
+func TestSobolStratification(t *testing.T) {
+	cases := map[string]struct {
+		dim int
+		k   uint
+	}{
+		"joe-kuo dimensions": {
+			dim: 21,
+			k:   10,
+		},
+		"generated dimensions": {
+			dim: 100,
+			k:   12,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, _ := random.NewSobol(c.dim)
+			n := 1 << c.k
+			s.Skip(n)
+			points := s.Points(n)
+
+			// Every block of 2^k points has exactly one point in every interval [i/2^k, (i+1)/2^k) of every axis.
+			for j := 0; j < c.dim; j++ {
+				seen := make([]bool, n)
+				for _, p := range points {
+					seen[int(p[j]*float64(n))] = true
+				}
+				for i := range seen {
+					assert.True(t, seen[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSobolSkip(t *testing.T) {
+	a, _ := random.NewSobol(5)
+	b, _ := random.NewSobol(5)
+
+	a.Points(37)
+	b.Skip(37)
+	assert.Equal(t, a.Next(), b.Next())
+}
+
+func TestSobolIntegration(t *testing.T) {
+	// The integral of the product of 2*x_j over the unit cube is 1 in every dimension.
+	s, _ := random.NewSobol(8)
+	s.Skip(1)
+	n := 1 << 14
+	var sum float64
+	for i := 0; i < n; i++ {
+		prod := 1.0
+		for _, x := range s.Next() {
+			prod *= 2 * x
+		}
+		sum += prod
+	}
+	assert.InDelta(t, 1, sum/float64(n), 5e-3)
+}
+
+func TestNewSobolInvalid(t *testing.T) {
+	_, err := random.NewSobol(0)
+	assert.Equal(t, fmt.Errorf("Dimension must be positive"), err)
+}
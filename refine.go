@@ -0,0 +1,171 @@
+package numericalgo
+
+import (
+	"fmt"
+	"math"
+)
+
+// Solver is a decomposition of a matrix which solves A*x = b for x, in the least squares sense for overdetermined
+// systems. It is implemented by LU (also when computed with LUFloat32) and QR, and used by SolveRefined.
+type Solver interface {
+	Solve(Vector) (Vector, error)
+}
+
+// refineMaxIter is the number of refinement steps of LeftDivideRefined. Each step reduces the error by a factor of
+// about cond(A) * eps, so matrices which benefit from refinement reach working precision in two or three steps and
+// the stopping tests of SolveRefined end the loop early; the limit only bounds the cost for ill-conditioned matrices.
+const refineMaxIter = 10
+
+// SolveRefined receives the right-hand side vector b, a decomposition of the matrix (LU, LUFloat32 or QR) and the
+// maximum number of refinement steps. It solves A*x = b (in the least squares sense for overdetermined systems) and
+// improves the solution by iterative refinement: the residual b - A*x is computed with compensated arithmetic,
+// which is about as accurate as computing it in twice the working precision, and the correction is obtained by
+// reusing the decomposition. Refinement stops when the correction no longer changes the solution in working
+// precision or stops decreasing. It returns the refined solution, its backward error, and the error (if there is any).
+//
+// When the decomposition was computed with LUFloat32, the expensive factorization runs in float32 while the solution
+// is still refined to full float64 accuracy, provided the matrix is not too ill-conditioned for float32.
+func (m Matrix) SolveRefined(b Vector, s Solver, maxIter int) (Vector, float64, error) {
+	if m.isNil() {
+		return nil, 0, fmt.Errorf("Matrix cannot be nil")
+	}
+
+	rows, cols := m.Dim()
+	if b.Dim() != rows {
+		return nil, 0, fmt.Errorf("Dimensions must match")
+	}
+
+	if maxIter < 0 {
+		return nil, 0, fmt.Errorf("Number of iterations cannot be negative")
+	}
+
+	x, err := s.Solve(b)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if x.Dim() != cols {
+		return nil, 0, fmt.Errorf("Solver does not match the matrix dimensions")
+	}
+
+	eps := machineEpsilon(false)
+	r := m.residual(x, b)
+	prev := math.Inf(1)
+
+	for i := 0; i < maxIter; i++ {
+		d, err := s.Solve(r)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// A growing correction means the refinement diverges, so the current solution is kept.
+		dNorm := d.maxAbs()
+		if dNorm >= prev {
+			break
+		}
+
+		for j := range x {
+			x[j] += d[j]
+		}
+		r = m.residual(x, b)
+
+		// Stop once the correction no longer changes the solution, or stagnates because the matrix is too
+		// ill-conditioned for the decomposition.
+		if dNorm <= eps*x.maxAbs() || dNorm > prev/2 {
+			break
+		}
+		prev = dNorm
+	}
+
+	be := m.backwardError(x, b, r)
+	return x, be, nil
+}
+
+// LeftDivideRefined receives another matrix as a parameter. Like LeftDivide, it solves A*X = B for X (in the least
+// squares sense when A has more rows than columns), but it uses an LU (square A) or QR (rectangular A) decomposition
+// followed by iterative refinement of every column of X, instead of forming the normal equations. It returns the
+// results in matrix form and error (if there is any).
+func (m Matrix) LeftDivideRefined(m2 Matrix) (Matrix, error) {
+	if err := m.checkLeftDivide(m2); err != nil {
+		return nil, err
+	}
+
+	var s Solver
+	var err error
+	if m.isSquare() {
+		s, err = m.LU()
+	} else {
+		s, err = m.QR()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return m.solveColumns(m2, func(b Vector) (Vector, error) {
+		x, _, err := m.SolveRefined(b, s, refineMaxIter)
+		return x, err
+	})
+}
+
+// residual returns b - A*x computed with compensated dot products.
+func (m Matrix) residual(x, b Vector) Vector {
+	r := make(Vector, len(m))
+	u := make(Vector, len(x)+1)
+	w := make(Vector, len(x)+1)
+	w[0] = 1
+	for j := range x {
+		w[j+1] = -x[j]
+	}
+
+	for i := range m {
+		u[0] = b[i]
+		copy(u[1:], m[i])
+		r[i] = dot2(u, w)
+	}
+	return r
+}
+
+// backwardError returns the componentwise backward error max|r_i| / (|A|*|x| + |b|)_i for square systems. For
+// overdetermined systems the residual does not vanish, so it returns the normwise estimate
+// ||A'*r|| / (||A|| * (||A||*||x|| + ||r||)) instead.
+func (m Matrix) backwardError(x, b, r Vector) float64 {
+	rows, cols := m.Dim()
+
+	if rows != cols {
+		var aNorm, xNorm, rNorm, gNorm float64
+		for i := range m {
+			for j := range m[i] {
+				aNorm = math.Hypot(aNorm, m[i][j])
+			}
+			rNorm = math.Hypot(rNorm, r[i])
+		}
+		for j := 0; j < cols; j++ {
+			xNorm = math.Hypot(xNorm, x[j])
+			col, _ := m.Col(j)
+			gNorm = math.Hypot(gNorm, dot2(col, r))
+		}
+
+		den := aNorm * (aNorm*xNorm + rNorm)
+		if den == 0 {
+			return 0
+		}
+		return gNorm / den
+	}
+
+	var be float64
+	for i := range m {
+		den := math.Abs(b[i])
+		for j := range m[i] {
+			den += math.Abs(m[i][j]) * math.Abs(x[j])
+		}
+
+		if den == 0 {
+			if r[i] != 0 {
+				return math.Inf(1)
+			}
+			continue
+		}
+		be = math.Max(be, math.Abs(r[i])/den)
+	}
+	return be
+}
@@ -0,0 +1,128 @@
+package numericalgo_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/stretchr/testify/assert"
+)
+
+// scaledHilbert returns the n x n Hilbert matrix multiplied by lcm(1, ..., 2n-1), so that all of its entries and
+// the right-hand side for the all-ones solution are exactly representable.
+func scaledHilbert(n int) (numericalgo.Matrix, numericalgo.Vector) {
+	lcm := 1
+	for k := 2; k < 2*n; k++ {
+		a, b := lcm, k
+		for b != 0 {
+			a, b = b, a%b
+		}
+		lcm = lcm / a * k
+	}
+
+	m := make(numericalgo.Matrix, n)
+	b := make(numericalgo.Vector, n)
+	for i := range m {
+		m[i] = make(numericalgo.Vector, n)
+		for j := range m[i] {
+			m[i][j] = float64(lcm / (i + j + 1))
+			b[i] += m[i][j]
+		}
+	}
+	return m, b
+}
+
+func maxError(v numericalgo.Vector, expected float64) float64 {
+	var e float64
+	for _, val := range v {
+		e = math.Max(e, math.Abs(val-expected))
+	}
+	return e
+}
+
+func TestSolveRefined(t *testing.T) {
+	m, b := scaledHilbert(8)
+
+	lu, err := m.LU()
+	assert.Equal(t, nil, err)
+
+	plain, err := lu.Solve(b)
+	assert.Equal(t, nil, err)
+
+	refined, be, err := m.SolveRefined(b, lu, 10)
+	assert.Equal(t, nil, err)
+	assert.True(t, be < 1e-15)
+	assert.True(t, maxError(refined, 1) < 1e-13)
+	assert.True(t, maxError(refined, 1) < maxError(plain, 1)/100)
+}
+
+func TestSolveRefinedSolvers(t *testing.T) {
+	m, b := scaledHilbert(6)
+	lu, _ := m.LU()
+	qr, _ := m.QR()
+
+	cases := map[string]struct {
+		solver numericalgo.Solver
+	}{
+		"LU": {solver: lu},
+		"QR": {solver: qr},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			refined, _, err := m.SolveRefined(b, c.solver, 10)
+			assert.Equal(t, nil, err)
+			assert.True(t, maxError(refined, 1) < 1e-10)
+		})
+	}
+}
+
+func TestSolveRefinedMixedPrecision(t *testing.T) {
+	m, b := scaledHilbert(4)
+
+	lu, err := m.LUFloat32()
+	assert.Equal(t, nil, err)
+
+	plain, err := lu.Solve(b)
+	assert.Equal(t, nil, err)
+	assert.True(t, maxError(plain, 1) > 1e-6)
+
+	refined, _, err := m.SolveRefined(b, lu, 50)
+	assert.Equal(t, nil, err)
+	assert.True(t, maxError(refined, 1) < 1e-13)
+}
+
+func TestSolveRefinedLeastSquares(t *testing.T) {
+	m := numericalgo.Matrix{
+		{1, 1.3},
+		{1, 2.1},
+		{1, 3.7},
+		{1, 4.2},
+	}
+	b := numericalgo.Vector{2.2, 5.8, 10.2, 11.8}
+
+	qr, err := m.QR()
+	assert.Equal(t, nil, err)
+
+	result, be, err := m.SolveRefined(b, qr, 5)
+	assert.Equal(t, nil, err)
+	assert.True(t, be < 1e-15)
+	assert.True(t, result.IsSimilar(numericalgo.Vector{-1.5225601452564678, 3.193826600090785}, 1e-13))
+}
+
+func TestMatrixLeftDivideRefined(t *testing.T) {
+	m, b := scaledHilbert(8)
+
+	var bMatrix numericalgo.Matrix
+	for _, val := range b {
+		bMatrix = append(bMatrix, numericalgo.Vector{val, 2 * val})
+	}
+
+	result, err := m.LeftDivideRefined(bMatrix)
+	assert.Equal(t, nil, err)
+
+	for _, row := range result {
+		assert.InEpsilon(t, 1, row[0], 1e-13)
+		assert.InEpsilon(t, 2, row[1], 1e-13)
+	}
+}
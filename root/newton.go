@@ -1,6 +1,11 @@
 package root
 
-import "github.com/DzananGanic/numericalgo/differentiate"
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo/autodiff"
+	"github.com/DzananGanic/numericalgo/differentiate"
+)
 
 // Newton receives three parameters. First parameter is the function we want to find root of. Second one is the initial guess (reasonably close to the true root). Third one is the number of iterations for the newton method. The Newton function returns the result as a float64, and the error.
 func Newton(f func(float64) float64, x0 float64, iter int) (float64, error) {
@@ -14,3 +19,52 @@ func Newton(f func(float64) float64, x0 float64, iter int) (float64, error) {
 
 	return x0, nil
 }
+
+// NewtonAdaptive receives the function, the initial guess and the number of iterations. It works like Newton, but
+// the derivative is computed with differentiate.Adaptive, so no step size has to be chosen and the root is found to
+// full precision. Every iteration costs up to 21 function evaluations instead of the 3 of Newton. It returns the
+// result as a float64, and the error.
+func NewtonAdaptive(f func(float64) float64, x0 float64, iter int) (float64, error) {
+	for i := 0; i < iter; i++ {
+		d, _, err := differentiate.Adaptive(f, x0)
+		if err != nil {
+			return 0, err
+		}
+		if d == 0 {
+			return 0, fmt.Errorf("Derivative is zero at %v", x0)
+		}
+		x0 = x0 - f(x0)/d
+	}
+
+	return x0, nil
+}
+
+// NewtonComplex receives an analytic function extended to complex arguments, the initial guess and the number of
+// iterations. It works like Newton, but the derivative is computed with differentiate.ComplexStep, which is exact to
+// machine precision and needs no step size. It returns the result as a float64, and the error.
+func NewtonComplex(f func(complex128) complex128, x0 float64, iter int) (float64, error) {
+	for i := 0; i < iter; i++ {
+		d := differentiate.ComplexStep(f, x0)
+		if d == 0 {
+			return 0, fmt.Errorf("Derivative is zero at %v", x0)
+		}
+		x0 = x0 - real(f(complex(x0, 0)))/d
+	}
+
+	return x0, nil
+}
+
+// NewtonDual receives a function written against autodiff dual numbers, the initial guess and the number of
+// iterations. It works like Newton, but the derivative is computed exactly with forward-mode automatic
+// differentiation. It returns the result as a float64, and the error.
+func NewtonDual(f func(autodiff.Dual) autodiff.Dual, x0 float64, iter int) (float64, error) {
+	for i := 0; i < iter; i++ {
+		v, d := autodiff.Derivative(f, x0)
+		if d == 0 {
+			return 0, fmt.Errorf("Derivative is zero at %v", x0)
+		}
+		x0 = x0 - v/d
+	}
+
+	return x0, nil
+}
@@ -1,9 +1,12 @@
 package root_test
 
 import (
+	"fmt"
 	"math"
+	"math/cmplx"
 	"testing"
 
+	"github.com/DzananGanic/numericalgo/autodiff"
 	"github.com/DzananGanic/numericalgo/root"
 	"github.com/stretchr/testify/assert"
 )
@@ -36,3 +39,189 @@ func TestNewton(t *testing.T) {
 		})
 	}
 }
+
+func TestNewtonEvaluations(t *testing.T) {
+	cases := map[string]struct {
+		newton func(f func(float64) float64, x0 float64, iter int) (float64, error)
+		min    int
+		max    int
+	}{
+		"central difference": {
+			newton: root.Newton,
+			min:    15,
+			max:    15,
+		},
+		"adaptive": {
+			newton: root.NewtonAdaptive,
+			min:    16,
+			max:    5 * 21,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var evaluations int
+			f := func(x float64) float64 {
+				evaluations++
+				return math.Cos(x) - x
+			}
+
+			_, err := c.newton(f, 1, 5)
+			assert.Equal(t, nil, err)
+			assert.True(t, evaluations >= c.min && evaluations <= c.max)
+		})
+	}
+}
+
+func TestNewtonAdaptive(t *testing.T) {
+
+	cases := map[string]struct {
+		f             func(x float64) float64
+		iter          int
+		initialGuess  float64
+		expectedValue float64
+		expectedError error
+	}{
+		"basic root finding": {
+			f: func(x float64) float64 {
+				return math.Pow(x, 3) - 2*math.Pow(x, 2) + 5
+			},
+			iter:          3,
+			initialGuess:  -1,
+			expectedValue: -1.2419,
+			expectedError: nil,
+		},
+		"converged root": {
+			f: func(x float64) float64 {
+				return math.Cos(x) - x
+			},
+			iter:          8,
+			initialGuess:  1,
+			expectedValue: 0.7390851332151607,
+			expectedError: nil,
+		},
+		"zero derivative": {
+			f: func(x float64) float64 {
+				return x*x + 1
+			},
+			iter:          3,
+			initialGuess:  0,
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Derivative is zero at 0"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := root.NewtonAdaptive(c.f, c.initialGuess, c.iter)
+			assert.Equal(t, c.expectedError, err)
+			if c.expectedValue == 0 {
+				assert.Equal(t, c.expectedValue, result)
+			} else {
+				assert.InEpsilon(t, c.expectedValue, result, 1e-4)
+			}
+		})
+	}
+}
+
+func TestNewtonComplex(t *testing.T) {
+
+	cases := map[string]struct {
+		f             func(z complex128) complex128
+		iter          int
+		initialGuess  float64
+		expectedValue float64
+		expectedError error
+	}{
+		"basic root finding": {
+			f: func(z complex128) complex128 {
+				return z*z*z - 2*z*z + 5
+			},
+			iter:          3,
+			initialGuess:  -1,
+			expectedValue: -1.2419,
+			expectedError: nil,
+		},
+		"converged root": {
+			f: func(z complex128) complex128 {
+				return cmplx.Cos(z) - z
+			},
+			iter:          8,
+			initialGuess:  1,
+			expectedValue: 0.7390851332151607,
+			expectedError: nil,
+		},
+		"zero derivative": {
+			f: func(z complex128) complex128 {
+				return z*z + 1
+			},
+			iter:          3,
+			initialGuess:  0,
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Derivative is zero at 0"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := root.NewtonComplex(c.f, c.initialGuess, c.iter)
+			assert.Equal(t, c.expectedError, err)
+			if c.expectedValue == 0 {
+				assert.Equal(t, c.expectedValue, result)
+			} else {
+				assert.InEpsilon(t, c.expectedValue, result, 1e-4)
+			}
+		})
+	}
+}
+
+func TestNewtonDual(t *testing.T) {
+
+	cases := map[string]struct {
+		f             func(x autodiff.Dual) autodiff.Dual
+		iter          int
+		initialGuess  float64
+		expectedValue float64
+		expectedError error
+	}{
+		"basic root finding": {
+			f: func(x autodiff.Dual) autodiff.Dual {
+				return autodiff.PowConst(x, 3).Sub(autodiff.PowConst(x, 2).Scale(2)).AddConst(5)
+			},
+			iter:          3,
+			initialGuess:  -1,
+			expectedValue: -1.2419,
+			expectedError: nil,
+		},
+		"converged root": {
+			f: func(x autodiff.Dual) autodiff.Dual {
+				return autodiff.Cos(x).Sub(x)
+			},
+			iter:          8,
+			initialGuess:  1,
+			expectedValue: 0.7390851332151607,
+			expectedError: nil,
+		},
+		"zero derivative": {
+			f: func(x autodiff.Dual) autodiff.Dual {
+				return x.Mul(x).AddConst(1)
+			},
+			iter:          3,
+			initialGuess:  0,
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Derivative is zero at 0"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := root.NewtonDual(c.f, c.initialGuess, c.iter)
+			assert.Equal(t, c.expectedError, err)
+			if c.expectedValue == 0 {
+				assert.Equal(t, c.expectedValue, result)
+			} else {
+				assert.InEpsilon(t, c.expectedValue, result, 1e-4)
+			}
+		})
+	}
+}
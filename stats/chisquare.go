@@ -0,0 +1,96 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/distuv"
+)
+
+// ChiSquareTest receives the observed frequencies, the expected frequencies and the number of parameters estimated
+// from the data. It performs Pearson's chi-square goodness-of-fit test, and returns the statistic
+// sum((o_i - e_i)^2 / e_i), its p-value and k - 1 - ddof degrees of freedom, and the error (if there is any). The
+// expected frequencies are rescaled to the total of the observed ones, so probabilities can be passed as well. A nil
+// expected vector tests against the uniform distribution.
+func ChiSquareTest(observed, expected numericalgo.Vector, ddof int) (TestResult, error) {
+	k := observed.Dim()
+	if expected == nil {
+		expected = make(numericalgo.Vector, k)
+		for i := range expected {
+			expected[i] = 1
+		}
+	}
+
+	if !observed.AreDimsEqual(expected) {
+		return TestResult{}, fmt.Errorf("Dimensions must match")
+	}
+
+	dof := k - 1 - ddof
+	if dof < 1 || ddof < 0 {
+		return TestResult{}, fmt.Errorf("Not enough categories for the given degrees of freedom")
+	}
+
+	for i := range observed {
+		if observed[i] < 0 || !(expected[i] > 0) {
+			return TestResult{}, fmt.Errorf("Observed frequencies cannot be negative and expected frequencies must be positive")
+		}
+	}
+
+	scale := observed.SumWith(numericalgo.Neumaier) / expected.SumWith(numericalgo.Neumaier)
+
+	var stat float64
+	for i := range observed {
+		e := expected[i] * scale
+		d := observed[i] - e
+		stat += d * d / e
+	}
+	return chiSquareResult(stat, float64(dof))
+}
+
+// ChiSquareIndependence receives a contingency table of observed frequencies. It performs Pearson's chi-square test
+// of independence of the row and column variables, and returns the statistic, its p-value and (r - 1)*(c - 1)
+// degrees of freedom, and the error (if there is any). No continuity correction is applied.
+func ChiSquareIndependence(table numericalgo.Matrix) (TestResult, error) {
+	rows, cols := table.Dim()
+	if rows < 2 || cols < 2 {
+		return TestResult{}, fmt.Errorf("Contingency table must have at least two rows and two columns")
+	}
+
+	rowSums := make(numericalgo.Vector, rows)
+	colSums := make(numericalgo.Vector, cols)
+	var total float64
+	for i := range table {
+		if len(table[i]) != cols {
+			return TestResult{}, fmt.Errorf("All rows must have the same number of columns")
+		}
+		for j, val := range table[i] {
+			if val < 0 {
+				return TestResult{}, fmt.Errorf("Observed frequencies cannot be negative")
+			}
+			rowSums[i] += val
+			colSums[j] += val
+			total += val
+		}
+	}
+
+	var stat float64
+	for i := range table {
+		for j, val := range table[i] {
+			e := rowSums[i] * colSums[j] / total
+			if e == 0 {
+				return TestResult{}, fmt.Errorf("Contingency table cannot have an empty row or column")
+			}
+			d := val - e
+			stat += d * d / e
+		}
+	}
+	return chiSquareResult(stat, float64((rows-1)*(cols-1)))
+}
+
+func chiSquareResult(stat, dof float64) (TestResult, error) {
+	d, err := distuv.NewChiSquared(dof, nil)
+	if err != nil {
+		return TestResult{}, err
+	}
+	return TestResult{Statistic: stat, PValue: d.Survival(stat), DoF: dof}, nil
+}
@@ -0,0 +1,117 @@
+package stats_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChiSquareTest(t *testing.T) {
+	cases := map[string]struct {
+		observed       numericalgo.Vector
+		expected       numericalgo.Vector
+		ddof           int
+		expectedResult stats.TestResult
+		expectedError  error
+	}{
+		"uniform": {
+			observed:       numericalgo.Vector{18, 22, 20, 40},
+			expected:       nil,
+			ddof:           0,
+			expectedResult: stats.TestResult{Statistic: 12.32, PValue: 0.006363629995195265, DoF: 3},
+			expectedError:  nil,
+		},
+		"frequencies": {
+			observed:       numericalgo.Vector{18, 22, 20, 40},
+			expected:       numericalgo.Vector{25, 25, 25, 25},
+			ddof:           0,
+			expectedResult: stats.TestResult{Statistic: 12.32, PValue: 0.006363629995195265, DoF: 3},
+			expectedError:  nil,
+		},
+		"probabilities": {
+			observed:       numericalgo.Vector{18, 22, 20, 40},
+			expected:       numericalgo.Vector{0.25, 0.25, 0.25, 0.25},
+			ddof:           0,
+			expectedResult: stats.TestResult{Statistic: 12.32, PValue: 0.006363629995195265, DoF: 3},
+			expectedError:  nil,
+		},
+		"one estimated parameter": {
+			observed:       numericalgo.Vector{1, 2, 3},
+			expected:       nil,
+			ddof:           1,
+			expectedResult: stats.TestResult{Statistic: 1, PValue: 0.31731050786291404, DoF: 1},
+			expectedError:  nil,
+		},
+		"dimensions do not match": {
+			observed:       numericalgo.Vector{1, 2},
+			expected:       numericalgo.Vector{1, 2, 3},
+			ddof:           0,
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Dimensions must match"),
+		},
+		"not enough categories": {
+			observed:       numericalgo.Vector{1, 2, 3},
+			expected:       nil,
+			ddof:           2,
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Not enough categories for the given degrees of freedom"),
+		},
+		"zero expected frequency": {
+			observed:       numericalgo.Vector{1, 2},
+			expected:       numericalgo.Vector{1, 0},
+			ddof:           0,
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Observed frequencies cannot be negative and expected frequencies must be positive"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.ChiSquareTest(c.observed, c.expected, c.ddof)
+			assert.InDelta(t, c.expectedResult.Statistic, result.Statistic, 1e-12)
+			assert.InDelta(t, c.expectedResult.PValue, result.PValue, 1e-12)
+			assert.Equal(t, c.expectedResult.DoF, result.DoF)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestChiSquareIndependence(t *testing.T) {
+	cases := map[string]struct {
+		table          numericalgo.Matrix
+		expectedResult stats.TestResult
+		expectedError  error
+	}{
+		"dependent": {
+			table: numericalgo.Matrix{
+				{10, 20, 30},
+				{20, 20, 10},
+			},
+			expectedResult: stats.TestResult{Statistic: 12.52777777777778, PValue: 0.001903827607695494, DoF: 2},
+			expectedError:  nil,
+		},
+		"single row": {
+			table:          numericalgo.Matrix{{1, 2}},
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Contingency table must have at least two rows and two columns"),
+		},
+		"empty column": {
+			table:          numericalgo.Matrix{{1, 0}, {2, 0}},
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Contingency table cannot have an empty row or column"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.ChiSquareIndependence(c.table)
+			assert.InDelta(t, c.expectedResult.Statistic, result.Statistic, 1e-12)
+			assert.InDelta(t, c.expectedResult.PValue, result.PValue, 1e-12)
+			assert.Equal(t, c.expectedResult.DoF, result.DoF)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
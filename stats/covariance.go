@@ -0,0 +1,79 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Covariance receives a data matrix, whose rows are observations and columns are variables, and the delta degrees of
+// freedom. It returns the covariance matrix of the variables and the error (if there is any).
+func Covariance(m numericalgo.Matrix, ddof int) (numericalgo.Matrix, error) {
+	rows, cols := m.Dim()
+	if rows-ddof <= 0 || ddof < 0 {
+		return nil, fmt.Errorf("Not enough observations for the given degrees of freedom")
+	}
+
+	centered := make([]numericalgo.Vector, cols)
+	for j := range centered {
+		col, err := m.Col(j)
+		if err != nil {
+			return nil, err
+		}
+
+		mean, err := Mean(col)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range col {
+			col[i] -= mean
+		}
+		centered[j] = col
+	}
+
+	r := make(numericalgo.Matrix, cols)
+	for i := range r {
+		r[i] = make(numericalgo.Vector, cols)
+	}
+
+	for i := 0; i < cols; i++ {
+		for j := i; j < cols; j++ {
+			d, err := centered[i].DotWith(centered[j], numericalgo.Neumaier)
+			if err != nil {
+				return nil, err
+			}
+			r[i][j] = d / float64(rows-ddof)
+			r[j][i] = r[i][j]
+		}
+	}
+
+	return r, nil
+}
+
+// Correlation receives a data matrix, whose rows are observations and columns are variables. It returns the matrix
+// of Pearson correlation coefficients between the variables and the error (if there is any).
+func Correlation(m numericalgo.Matrix) (numericalgo.Matrix, error) {
+	cov, err := Covariance(m, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range cov {
+		if cov[i][i] == 0 {
+			return nil, fmt.Errorf("Correlation is undefined for constant variables")
+		}
+	}
+
+	r := make(numericalgo.Matrix, len(cov))
+	for i := range cov {
+		r[i] = make(numericalgo.Vector, len(cov))
+		for j := range cov {
+			r[i][j] = cov[i][j] / math.Sqrt(cov[i][i]*cov[j][j])
+		}
+		r[i][i] = 1
+	}
+
+	return r, nil
+}
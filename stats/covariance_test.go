@@ -0,0 +1,72 @@
+package stats_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCovariance(t *testing.T) {
+	cases := map[string]struct {
+		matrix         numericalgo.Matrix
+		ddof           int
+		expectedResult numericalgo.Matrix
+		expectedError  error
+	}{
+		"sample covariance": {
+			matrix:         numericalgo.Matrix{{1, 2}, {2, 4}, {3, 7}},
+			ddof:           1,
+			expectedResult: numericalgo.Matrix{{1, 2.5}, {2.5, 57.0 / 9}},
+			expectedError:  nil,
+		},
+		"not enough observations": {
+			matrix:         numericalgo.Matrix{{1, 2}},
+			ddof:           1,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Not enough observations for the given degrees of freedom"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.Covariance(c.matrix, c.ddof)
+			assert.True(t, result.IsSimilar(c.expectedResult, 1e-12))
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestCorrelation(t *testing.T) {
+	cases := map[string]struct {
+		matrix         numericalgo.Matrix
+		expectedResult numericalgo.Matrix
+		expectedError  error
+	}{
+		"basic correlation": {
+			matrix:         numericalgo.Matrix{{1, 2}, {2, 4}, {3, 7}},
+			expectedResult: numericalgo.Matrix{{1, 0.9933992677987828}, {0.9933992677987828, 1}},
+			expectedError:  nil,
+		},
+		"perfect negative correlation": {
+			matrix:         numericalgo.Matrix{{1, 6}, {2, 4}, {3, 2}},
+			expectedResult: numericalgo.Matrix{{1, -1}, {-1, 1}},
+			expectedError:  nil,
+		},
+		"constant variable": {
+			matrix:         numericalgo.Matrix{{1, 2}, {2, 2}, {3, 2}},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Correlation is undefined for constant variables"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.Correlation(c.matrix)
+			assert.True(t, result.IsSimilar(c.expectedResult, 1e-12))
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
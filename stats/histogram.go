@@ -0,0 +1,94 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Histogram receives a vector and the number of bins. It divides the range between the smallest and the largest
+// element into equally wide bins, and returns the number of elements in every bin, the bin edges and the error (if
+// there is any). The elements must be finite.
+func Histogram(v numericalgo.Vector, bins int) ([]int, numericalgo.Vector, error) {
+	if bins <= 0 {
+		return nil, nil, fmt.Errorf("Number of bins must be positive")
+	}
+
+	for _, val := range v {
+		if math.IsNaN(val) {
+			return nil, nil, fmt.Errorf("Vector cannot contain NaN")
+		}
+		if math.IsInf(val, 0) {
+			return nil, nil, fmt.Errorf("Vector cannot contain infinite values")
+		}
+	}
+
+	lo, err := v.Min()
+	if err != nil {
+		return nil, nil, err
+	}
+	hi, _ := v.Max()
+
+	if lo == hi {
+		lo, hi = lo-0.5, hi+0.5
+	}
+
+	edges := make(numericalgo.Vector, bins+1)
+	for i := range edges {
+		edges[i] = lo + (hi-lo)*float64(i)/float64(bins)
+	}
+	edges[bins] = hi
+
+	counts, err := HistogramEdges(v, edges)
+	if err != nil {
+		return nil, nil, err
+	}
+	return counts, edges, nil
+}
+
+// HistogramEdges receives a vector and the finite, increasing bin edges. It returns the number of elements in every
+// bin and the error (if there is any). Every bin includes its left edge, and the last bin includes its right edge as
+// well. Elements outside of the edges are not counted, but NaN elements are rejected.
+func HistogramEdges(v, edges numericalgo.Vector) ([]int, error) {
+	if edges.Dim() < 2 {
+		return nil, fmt.Errorf("At least 2 bin edges are required")
+	}
+
+	for _, e := range edges {
+		if math.IsNaN(e) || math.IsInf(e, 0) {
+			return nil, fmt.Errorf("Bin edges must be finite")
+		}
+	}
+	for i := 1; i < len(edges); i++ {
+		if edges[i] <= edges[i-1] {
+			return nil, fmt.Errorf("Bin edges must be strictly increasing")
+		}
+	}
+
+	for _, val := range v {
+		if math.IsNaN(val) {
+			return nil, fmt.Errorf("Vector cannot contain NaN")
+		}
+	}
+
+	bins := len(edges) - 1
+	counts := make([]int, bins)
+	for _, val := range v {
+		if val < edges[0] || val > edges[bins] {
+			continue
+		}
+
+		i := sort.SearchFloat64s(edges, val)
+		if i < len(edges) && edges[i] == val {
+			i++
+		}
+		if i > bins {
+			i = bins
+		}
+		counts[i-1]++
+	}
+
+	return counts, nil
+}
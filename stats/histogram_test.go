@@ -0,0 +1,120 @@
+package stats_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram(t *testing.T) {
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		bins           int
+		expectedCounts []int
+		expectedEdges  numericalgo.Vector
+		expectedError  error
+	}{
+		"basic histogram": {
+			vector:         numericalgo.Vector{1, 2, 2, 3, 4, 5},
+			bins:           4,
+			expectedCounts: []int{1, 2, 1, 2},
+			expectedEdges:  numericalgo.Vector{1, 2, 3, 4, 5},
+			expectedError:  nil,
+		},
+		"constant data": {
+			vector:         numericalgo.Vector{2, 2},
+			bins:           2,
+			expectedCounts: []int{0, 2},
+			expectedEdges:  numericalgo.Vector{1.5, 2, 2.5},
+			expectedError:  nil,
+		},
+		"invalid number of bins": {
+			vector:         numericalgo.Vector{1, 2},
+			bins:           0,
+			expectedCounts: nil,
+			expectedEdges:  nil,
+			expectedError:  fmt.Errorf("Number of bins must be positive"),
+		},
+		"NaN element": {
+			vector:         numericalgo.Vector{1, math.NaN(), 2},
+			bins:           2,
+			expectedCounts: nil,
+			expectedEdges:  nil,
+			expectedError:  fmt.Errorf("Vector cannot contain NaN"),
+		},
+		"infinite element": {
+			vector:         numericalgo.Vector{1, math.Inf(1)},
+			bins:           2,
+			expectedCounts: nil,
+			expectedEdges:  nil,
+			expectedError:  fmt.Errorf("Vector cannot contain infinite values"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			counts, edges, err := stats.Histogram(c.vector, c.bins)
+			assert.Equal(t, c.expectedCounts, counts)
+			assert.Equal(t, c.expectedEdges, edges)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestHistogramEdges(t *testing.T) {
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		edges          numericalgo.Vector
+		expectedCounts []int
+		expectedError  error
+	}{
+		"values outside of the edges are ignored": {
+			vector:         numericalgo.Vector{-1, 0, 0.5, 1, 3, 10},
+			edges:          numericalgo.Vector{0, 1, 3},
+			expectedCounts: []int{2, 2},
+			expectedError:  nil,
+		},
+		"decreasing edges": {
+			vector:         numericalgo.Vector{1},
+			edges:          numericalgo.Vector{1, 0},
+			expectedCounts: nil,
+			expectedError:  fmt.Errorf("Bin edges must be strictly increasing"),
+		},
+		"infinite values are outside of finite edges": {
+			vector:         numericalgo.Vector{math.Inf(-1), 0.5, math.Inf(1)},
+			edges:          numericalgo.Vector{0, 1},
+			expectedCounts: []int{1},
+			expectedError:  nil,
+		},
+		"NaN element": {
+			vector:         numericalgo.Vector{0.5, math.NaN()},
+			edges:          numericalgo.Vector{0, 1},
+			expectedCounts: nil,
+			expectedError:  fmt.Errorf("Vector cannot contain NaN"),
+		},
+		"NaN edge": {
+			vector:         numericalgo.Vector{0.5},
+			edges:          numericalgo.Vector{math.NaN(), 1},
+			expectedCounts: nil,
+			expectedError:  fmt.Errorf("Bin edges must be finite"),
+		},
+		"infinite edge": {
+			vector:         numericalgo.Vector{0.5},
+			edges:          numericalgo.Vector{0, math.Inf(1)},
+			expectedCounts: nil,
+			expectedError:  fmt.Errorf("Bin edges must be finite"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			counts, err := stats.HistogramEdges(c.vector, c.edges)
+			assert.Equal(t, c.expectedCounts, counts)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package stats
+
+import (
+	"math"
+
+	"github.com/DzananGanic/numericalgo/distuv"
+)
+
+// Alternative is the alternative hypothesis of a statistical test.
+type Alternative int
+
+const (
+	// TwoSided tests whether the parameter differs from its value under the null hypothesis.
+	TwoSided Alternative = iota
+	// Less tests whether the parameter is less than its value under the null hypothesis.
+	Less
+	// Greater tests whether the parameter is greater than its value under the null hypothesis.
+	Greater
+)
+
+// TestResult holds the outcome of a statistical test: the test statistic, the p-value and the degrees of freedom of
+// the null distribution of the statistic. DoF is zero for tests whose null distribution has no degrees of freedom.
+type TestResult struct {
+	Statistic float64
+	PValue    float64
+	DoF       float64
+}
+
+// tailProbability returns the p-value of the statistic for the alternative, given the CDF and survival function
+// of its null distribution, which must be symmetric around zero for two-sided alternatives.
+func tailProbability(stat float64, cdf, survival func(float64) float64, alt Alternative) float64 {
+	switch alt {
+	case Less:
+		return cdf(stat)
+	case Greater:
+		return survival(stat)
+	}
+	return math.Min(1, 2*survival(math.Abs(stat)))
+}
+
+func standardNormal() *distuv.Normal {
+	n, _ := distuv.NewNormal(0, 1, nil)
+	return n
+}
@@ -0,0 +1,86 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// KSTest receives a sample and the cumulative distribution function of the hypothesized continuous distribution. It
+// performs the one-sample Kolmogorov-Smirnov test, and returns the statistic D = sup|F_n(x) - F(x)| and its p-value,
+// and the error (if there is any). The p-value comes from the asymptotic Kolmogorov distribution with the
+// small-sample correction of Stephens, which is accurate to a few percent for n >= 5.
+func KSTest(v numericalgo.Vector, cdf func(float64) float64) (TestResult, error) {
+	n := v.Dim()
+	if n == 0 {
+		return TestResult{}, fmt.Errorf("Vector cannot be empty")
+	}
+
+	x := sortedCopy(v)
+	var d float64
+	for i, val := range x {
+		f := cdf(val)
+		d = math.Max(d, math.Max(float64(i+1)/float64(n)-f, f-float64(i)/float64(n)))
+	}
+
+	return TestResult{Statistic: d, PValue: kolmogorovSurvival(d, float64(n))}, nil
+}
+
+// KSTest2 receives two independent samples. It performs the two-sample Kolmogorov-Smirnov test of whether they come
+// from the same continuous distribution, and returns the statistic D = sup|F_a(x) - F_b(x)| and its p-value, and the
+// error (if there is any). The p-value is asymptotic with the effective sample size n_a*n_b / (n_a + n_b).
+func KSTest2(a, b numericalgo.Vector) (TestResult, error) {
+	if a.Dim() == 0 || b.Dim() == 0 {
+		return TestResult{}, fmt.Errorf("Vector cannot be empty")
+	}
+
+	x, y := sortedCopy(a), sortedCopy(b)
+	na, nb := float64(len(x)), float64(len(y))
+
+	var d float64
+	var i, j int
+	for i < len(x) && j < len(y) {
+		// Advance past all copies of the smaller value so ties between the samples are handled.
+		v := math.Min(x[i], y[j])
+		for i < len(x) && x[i] == v {
+			i++
+		}
+		for j < len(y) && y[j] == v {
+			j++
+		}
+		d = math.Max(d, math.Abs(float64(i)/na-float64(j)/nb))
+	}
+
+	return TestResult{Statistic: d, PValue: kolmogorovSurvival(d, na*nb/(na+nb))}, nil
+}
+
+// kolmogorovSurvival returns the asymptotic probability that the Kolmogorov-Smirnov statistic of a sample of the
+// effective size n exceeds d.
+func kolmogorovSurvival(d, n float64) float64 {
+	sn := math.Sqrt(n)
+	lambda := (sn + 0.12 + 0.11/sn) * d
+	if lambda < 0.2 {
+		return 1
+	}
+
+	var sum float64
+	sign := 1.0
+	for k := 1.0; k <= 100; k++ {
+		term := sign * math.Exp(-2*k*k*lambda*lambda)
+		sum += term
+		if math.Abs(term) <= 1e-16*sum {
+			break
+		}
+		sign = -sign
+	}
+	return math.Max(0, math.Min(1, 2*sum))
+}
+
+func sortedCopy(v numericalgo.Vector) numericalgo.Vector {
+	x := make(numericalgo.Vector, v.Dim())
+	copy(x, v)
+	sort.Float64s(x)
+	return x
+}
@@ -0,0 +1,85 @@
+package stats_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/distuv"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKSTest(t *testing.T) {
+	uniform, _ := distuv.NewUniform(0, 1, nil)
+
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		expectedResult stats.TestResult
+		expectedError  error
+	}{
+		"skewed sample": {
+			vector:         numericalgo.Vector{0.45, 0.01, 0.4, 0.02, 0.35, 0.05, 0.3, 0.1, 0.2, 0.15},
+			expectedResult: stats.TestResult{Statistic: 0.55, PValue: 0.0025706143095149737},
+			expectedError:  nil,
+		},
+		"empty vector": {
+			vector:         numericalgo.Vector{},
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Vector cannot be empty"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.KSTest(c.vector, uniform.CDF)
+			assert.InDelta(t, c.expectedResult.Statistic, result.Statistic, 1e-12)
+			assert.InDelta(t, c.expectedResult.PValue, result.PValue, 1e-12)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestKSTestNormalSample(t *testing.T) {
+	n, _ := distuv.NewNormal(0, 1, rand.NewSource(1))
+	v := make(numericalgo.Vector, 500)
+	for i := range v {
+		v[i] = n.Rand()
+	}
+
+	result, err := stats.KSTest(v, n.CDF)
+	assert.True(t, result.PValue > 0.05)
+	assert.Equal(t, nil, err)
+}
+
+func TestKSTest2(t *testing.T) {
+	cases := map[string]struct {
+		a              numericalgo.Vector
+		b              numericalgo.Vector
+		expectedResult stats.TestResult
+		expectedError  error
+	}{
+		"shifted samples": {
+			a:              numericalgo.Vector{1, 2, 3, 4, 5, 6, 7, 8},
+			b:              numericalgo.Vector{5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+			expectedResult: stats.TestResult{Statistic: 0.6, PValue: 0.04731608707336757},
+			expectedError:  nil,
+		},
+		"empty vector": {
+			a:              numericalgo.Vector{},
+			b:              numericalgo.Vector{1},
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Vector cannot be empty"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.KSTest2(c.a, c.b)
+			assert.InDelta(t, c.expectedResult.Statistic, result.Statistic, 1e-12)
+			assert.InDelta(t, c.expectedResult.PValue, result.PValue, 1e-12)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// MannWhitneyU receives two independent samples and the alternative hypothesis. It performs the Mann-Whitney U
+// (Wilcoxon rank-sum) test of whether values of a tend to be larger (Greater) or smaller (Less) than values of b, and
+// returns the U statistic of a and its p-value, and the error (if there is any). Tied values get the average of
+// their ranks. The p-value uses the normal approximation with tie and continuity corrections, which is adequate when
+// both samples have more than about 8 elements.
+func MannWhitneyU(a, b numericalgo.Vector, alt Alternative) (TestResult, error) {
+	na, nb := a.Dim(), b.Dim()
+	if na == 0 || nb == 0 {
+		return TestResult{}, fmt.Errorf("Vector cannot be empty")
+	}
+
+	all := append(append(numericalgo.Vector{}, a...), b...)
+	ranks, ties := rank(all)
+
+	var rankSum float64
+	for i := 0; i < na; i++ {
+		rankSum += ranks[i]
+	}
+
+	fa, fb := float64(na), float64(nb)
+	n := fa + fb
+	u := rankSum - fa*(fa+1)/2
+
+	mean := fa * fb / 2
+	variance := fa * fb / 12 * (n + 1 - ties/(n*(n-1)))
+	if variance <= 0 {
+		return TestResult{}, fmt.Errorf("All values are tied")
+	}
+	sd := math.Sqrt(variance)
+
+	norm := standardNormal()
+	upper := norm.Survival((u - mean - 0.5) / sd)
+	lower := norm.CDF((u - mean + 0.5) / sd)
+
+	var p float64
+	switch alt {
+	case Less:
+		p = lower
+	case Greater:
+		p = upper
+	default:
+		p = math.Min(1, 2*math.Min(upper, lower))
+	}
+	return TestResult{Statistic: u, PValue: p}, nil
+}
+
+// rank returns the 1-based ranks of the elements, with tied elements getting the average of their ranks, and the
+// tie correction sum(t^3 - t) over the groups of t tied elements.
+func rank(v numericalgo.Vector) (numericalgo.Vector, float64) {
+	idx := make([]int, len(v))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return v[idx[i]] < v[idx[j]]
+	})
+
+	ranks := make(numericalgo.Vector, len(v))
+	var ties float64
+	for i := 0; i < len(idx); {
+		j := i + 1
+		for j < len(idx) && v[idx[j]] == v[idx[i]] {
+			j++
+		}
+		r := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			ranks[idx[k]] = r
+		}
+		t := float64(j - i)
+		ties += t*t*t - t
+		i = j
+	}
+	return ranks, ties
+}
@@ -0,0 +1,65 @@
+package stats_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMannWhitneyU(t *testing.T) {
+	cases := map[string]struct {
+		a              numericalgo.Vector
+		b              numericalgo.Vector
+		alt            stats.Alternative
+		expectedResult stats.TestResult
+		expectedError  error
+	}{
+		"two sided": {
+			a:              numericalgo.Vector{1, 2, 3, 4, 5, 6, 7, 8},
+			b:              numericalgo.Vector{5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+			alt:            stats.TwoSided,
+			expectedResult: stats.TestResult{Statistic: 8, PValue: 0.005037231088190328},
+			expectedError:  nil,
+		},
+		"less": {
+			a:              numericalgo.Vector{1, 2, 3, 4, 5, 6, 7, 8},
+			b:              numericalgo.Vector{5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+			alt:            stats.Less,
+			expectedResult: stats.TestResult{Statistic: 8, PValue: 0.002518615544095164},
+			expectedError:  nil,
+		},
+		"greater": {
+			a:              numericalgo.Vector{1, 2, 3, 4, 5, 6, 7, 8},
+			b:              numericalgo.Vector{5, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+			alt:            stats.Greater,
+			expectedResult: stats.TestResult{Statistic: 8, PValue: 0.9980962168216158},
+			expectedError:  nil,
+		},
+		"empty vector": {
+			a:              numericalgo.Vector{},
+			b:              numericalgo.Vector{1},
+			alt:            stats.TwoSided,
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Vector cannot be empty"),
+		},
+		"all values tied": {
+			a:              numericalgo.Vector{1, 1},
+			b:              numericalgo.Vector{1},
+			alt:            stats.TwoSided,
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("All values are tied"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.MannWhitneyU(c.a, c.b, c.alt)
+			assert.Equal(t, c.expectedResult.Statistic, result.Statistic)
+			assert.InDelta(t, c.expectedResult.PValue, result.PValue, 1e-12)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Mean receives a vector and returns the arithmetic mean of its elements, and the error (if there is any).
+func Mean(v numericalgo.Vector) (float64, error) {
+	if v.Dim() == 0 {
+		return 0, fmt.Errorf("Vector cannot be empty")
+	}
+	return v.SumWith(numericalgo.Neumaier) / float64(v.Dim()), nil
+}
+
+// WeightedMean receives a vector and the weights of its elements. It returns the weighted arithmetic mean
+// sum(w_i*x_i) / sum(w_i), and the error (if there is any). Weights cannot be negative and cannot all be zero.
+func WeightedMean(v, w numericalgo.Vector) (float64, error) {
+	if v.Dim() == 0 {
+		return 0, fmt.Errorf("Vector cannot be empty")
+	}
+
+	if !v.AreDimsEqual(w) {
+		return 0, fmt.Errorf("Dimensions must match")
+	}
+
+	for _, val := range w {
+		if val < 0 {
+			return 0, fmt.Errorf("Weights cannot be negative")
+		}
+	}
+
+	total := w.SumWith(numericalgo.Neumaier)
+	if total == 0 {
+		return 0, fmt.Errorf("Weights cannot all be zero")
+	}
+
+	weighted, err := v.DotWith(w, numericalgo.Neumaier)
+	if err != nil {
+		return 0, err
+	}
+	return weighted / total, nil
+}
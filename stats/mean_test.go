@@ -0,0 +1,79 @@
+package stats_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMean(t *testing.T) {
+	cases := map[string]struct {
+		vector        numericalgo.Vector
+		expectedValue float64
+		expectedError error
+	}{
+		"basic mean": {
+			vector:        numericalgo.Vector{2, 4, 4, 4, 5, 5, 7, 9},
+			expectedValue: 5,
+			expectedError: nil,
+		},
+		"empty vector": {
+			vector:        numericalgo.Vector{},
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Vector cannot be empty"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.Mean(c.vector)
+			assert.Equal(t, c.expectedValue, result)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestWeightedMean(t *testing.T) {
+	cases := map[string]struct {
+		vector        numericalgo.Vector
+		weights       numericalgo.Vector
+		expectedValue float64
+		expectedError error
+	}{
+		"basic weighted mean": {
+			vector:        numericalgo.Vector{1, 2, 3},
+			weights:       numericalgo.Vector{3, 0, 1},
+			expectedValue: 1.5,
+			expectedError: nil,
+		},
+		"negative weight": {
+			vector:        numericalgo.Vector{1, 2},
+			weights:       numericalgo.Vector{1, -1},
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Weights cannot be negative"),
+		},
+		"zero weights": {
+			vector:        numericalgo.Vector{1, 2},
+			weights:       numericalgo.Vector{0, 0},
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Weights cannot all be zero"),
+		},
+		"wrong dimensions": {
+			vector:        numericalgo.Vector{1, 2},
+			weights:       numericalgo.Vector{1},
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Dimensions must match"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.WeightedMean(c.vector, c.weights)
+			assert.Equal(t, c.expectedValue, result)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
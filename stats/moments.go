@@ -0,0 +1,99 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Skewness receives a vector and the bias flag. It returns the sample skewness of the elements and the error (if there
+// is any). With bias set to true it returns the Fisher-Pearson coefficient m3 / m2^(3/2) computed from the central
+// moments, and with bias set to false the adjusted coefficient sqrt(n*(n-1)) / (n-2) * m3 / m2^(3/2).
+func Skewness(v numericalgo.Vector, bias bool) (float64, error) {
+	n := float64(v.Dim())
+	if !bias && n < 3 {
+		return 0, fmt.Errorf("Unbiased skewness requires at least 3 elements")
+	}
+
+	m2, m3, _, err := centralMoments(v)
+	if err != nil {
+		return 0, err
+	}
+
+	if m2 == 0 {
+		return 0, fmt.Errorf("Skewness is undefined for constant data")
+	}
+
+	g1 := m3 / math.Pow(m2, 1.5)
+	if bias {
+		return g1, nil
+	}
+	return math.Sqrt(n*(n-1)) / (n - 2) * g1, nil
+}
+
+// Kurtosis receives a vector and the bias flag. It returns the sample excess kurtosis of the elements (zero for the
+// normal distribution) and the error (if there is any). With bias set to true it returns m4 / m2^2 - 3 computed from
+// the central moments, and with bias set to false the adjusted estimator used by most statistical packages.
+func Kurtosis(v numericalgo.Vector, bias bool) (float64, error) {
+	n := float64(v.Dim())
+	if !bias && n < 4 {
+		return 0, fmt.Errorf("Unbiased kurtosis requires at least 4 elements")
+	}
+
+	m2, _, m4, err := centralMoments(v)
+	if err != nil {
+		return 0, err
+	}
+
+	if m2 == 0 {
+		return 0, fmt.Errorf("Kurtosis is undefined for constant data")
+	}
+
+	g2 := m4/(m2*m2) - 3
+	if bias {
+		return g2, nil
+	}
+	return ((n+1)*g2 + 6) * (n - 1) / ((n - 2) * (n - 3)), nil
+}
+
+// Mode receives a vector and returns its most frequent element, and the error (if there is any). If several elements
+// are equally frequent, the smallest of them is returned.
+func Mode(v numericalgo.Vector) (float64, error) {
+	if v.Dim() == 0 {
+		return 0, fmt.Errorf("Vector cannot be empty")
+	}
+
+	counts := make(map[float64]int)
+	for _, val := range v {
+		counts[val]++
+	}
+
+	var mode float64
+	best := 0
+	for _, val := range v.Unique() {
+		if counts[val] > best {
+			mode, best = val, counts[val]
+		}
+	}
+	return mode, nil
+}
+
+// centralMoments returns the 2nd, 3rd and 4th central moments of the elements.
+func centralMoments(v numericalgo.Vector) (float64, float64, float64, error) {
+	mean, err := Mean(v)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	var m2, m3, m4 float64
+	for _, val := range v {
+		d := val - mean
+		m2 += d * d
+		m3 += d * d * d
+		m4 += d * d * d * d
+	}
+
+	n := float64(v.Dim())
+	return m2 / n, m3 / n, m4 / n, nil
+}
@@ -0,0 +1,120 @@
+package stats_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkewness(t *testing.T) {
+	cases := map[string]struct {
+		vector        numericalgo.Vector
+		bias          bool
+		expectedValue float64
+		expectedError error
+	}{
+		"biased skewness": {
+			vector:        numericalgo.Vector{2, 4, 4, 4, 5, 5, 7, 9},
+			bias:          true,
+			expectedValue: 0.65625,
+			expectedError: nil,
+		},
+		"unbiased skewness": {
+			vector:        numericalgo.Vector{2, 4, 4, 4, 5, 5, 7, 9},
+			bias:          false,
+			expectedValue: 0.8184875533567996,
+			expectedError: nil,
+		},
+		"symmetric data": {
+			vector:        numericalgo.Vector{1, 2, 3},
+			bias:          true,
+			expectedValue: 0,
+			expectedError: nil,
+		},
+		"constant data": {
+			vector:        numericalgo.Vector{1, 1, 1},
+			bias:          true,
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Skewness is undefined for constant data"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.Skewness(c.vector, c.bias)
+			assert.InDelta(t, c.expectedValue, result, 1e-12)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestKurtosis(t *testing.T) {
+	cases := map[string]struct {
+		vector        numericalgo.Vector
+		bias          bool
+		expectedValue float64
+		expectedError error
+	}{
+		"biased kurtosis": {
+			vector:        numericalgo.Vector{2, 4, 4, 4, 5, 5, 7, 9},
+			bias:          true,
+			expectedValue: -0.21875,
+			expectedError: nil,
+		},
+		"unbiased kurtosis": {
+			vector:        numericalgo.Vector{2, 4, 4, 4, 5, 5, 7, 9},
+			bias:          false,
+			expectedValue: 0.940625,
+			expectedError: nil,
+		},
+		"not enough elements": {
+			vector:        numericalgo.Vector{1, 2, 3},
+			bias:          false,
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Unbiased kurtosis requires at least 4 elements"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.Kurtosis(c.vector, c.bias)
+			assert.InDelta(t, c.expectedValue, result, 1e-12)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestMode(t *testing.T) {
+	cases := map[string]struct {
+		vector        numericalgo.Vector
+		expectedValue float64
+		expectedError error
+	}{
+		"basic mode": {
+			vector:        numericalgo.Vector{2, 4, 4, 4, 5, 5, 7, 9},
+			expectedValue: 4,
+			expectedError: nil,
+		},
+		"ties return the smallest value": {
+			vector:        numericalgo.Vector{3, 3, 1, 1, 2},
+			expectedValue: 1,
+			expectedError: nil,
+		},
+		"empty vector": {
+			vector:        numericalgo.Vector{},
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Vector cannot be empty"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.Mode(c.vector)
+			assert.Equal(t, c.expectedValue, result)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
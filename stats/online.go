@@ -0,0 +1,412 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Moments accumulates the count, mean, variance, minimum and maximum of a stream of values one sample at a time,
+// using Welford's algorithm, without storing the samples. Moments is not safe for concurrent use. Instead, every
+// goroutine should accumulate its own Moments, and the results should be combined with Merge.
+type Moments struct {
+	n        int
+	mean     float64
+	m2       float64
+	min, max float64
+}
+
+// NewMoments returns the pointer to the new, empty Moments accumulator. The zero value of Moments is an empty
+// accumulator as well.
+func NewMoments() *Moments {
+	return &Moments{min: math.Inf(1), max: math.Inf(-1)}
+}
+
+// Add receives a sample and adds it to the accumulator.
+func (a *Moments) Add(x float64) {
+	if a.n == 0 {
+		a.min, a.max = x, x
+	}
+	a.n++
+	d := x - a.mean
+	a.mean += d / float64(a.n)
+	a.m2 += d * (x - a.mean)
+	a.min = math.Min(a.min, x)
+	a.max = math.Max(a.max, x)
+}
+
+// Merge receives another accumulator and adds all of its samples to this accumulator, as if they were added one by
+// one. The other accumulator is left unchanged.
+func (a *Moments) Merge(b *Moments) {
+	if b.n == 0 {
+		return
+	}
+	if a.n == 0 {
+		*a = *b
+		return
+	}
+
+	n := a.n + b.n
+	d := b.mean - a.mean
+	a.mean += d * float64(b.n) / float64(n)
+	a.m2 += b.m2 + d*d*float64(a.n)*float64(b.n)/float64(n)
+	a.n = n
+	a.min = math.Min(a.min, b.min)
+	a.max = math.Max(a.max, b.max)
+}
+
+// Count returns the number of accumulated samples.
+func (a *Moments) Count() int {
+	return a.n
+}
+
+// Mean returns the mean of the accumulated samples and the error (if there is any).
+func (a *Moments) Mean() (float64, error) {
+	if a.n == 0 {
+		return 0, fmt.Errorf("No samples have been added")
+	}
+	return a.mean, nil
+}
+
+// Variance receives the delta degrees of freedom. It returns the variance of the accumulated samples and the error
+// (if there is any).
+func (a *Moments) Variance(ddof int) (float64, error) {
+	if a.n-ddof <= 0 || ddof < 0 {
+		return 0, fmt.Errorf("Not enough elements for the given degrees of freedom")
+	}
+	return a.m2 / float64(a.n-ddof), nil
+}
+
+// StdDev receives the delta degrees of freedom. It returns the standard deviation of the accumulated samples and the
+// error (if there is any).
+func (a *Moments) StdDev(ddof int) (float64, error) {
+	variance, err := a.Variance(ddof)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
+
+// Min returns the smallest accumulated sample and the error (if there is any).
+func (a *Moments) Min() (float64, error) {
+	if a.n == 0 {
+		return 0, fmt.Errorf("No samples have been added")
+	}
+	return a.min, nil
+}
+
+// Max returns the largest accumulated sample and the error (if there is any).
+func (a *Moments) Max() (float64, error) {
+	if a.n == 0 {
+		return 0, fmt.Errorf("No samples have been added")
+	}
+	return a.max, nil
+}
+
+// CovarianceAccumulator accumulates the means and the covariance matrix of a stream of multivariate observations one
+// observation at a time, using the multivariate generalization of Welford's algorithm. It is not safe for concurrent
+// use. Instead, every goroutine should accumulate its own CovarianceAccumulator, and the results should be combined
+// with Merge.
+type CovarianceAccumulator struct {
+	n    int
+	mean numericalgo.Vector
+	c    numericalgo.Matrix
+}
+
+// NewCovarianceAccumulator receives the number of variables and returns the pointer to the new, empty
+// CovarianceAccumulator.
+func NewCovarianceAccumulator(dim int) *CovarianceAccumulator {
+	c := make(numericalgo.Matrix, dim)
+	for i := range c {
+		c[i] = make(numericalgo.Vector, dim)
+	}
+	return &CovarianceAccumulator{mean: make(numericalgo.Vector, dim), c: c}
+}
+
+// Add receives an observation with one value per variable, adds it to the accumulator, and returns the error (if
+// there is any).
+func (a *CovarianceAccumulator) Add(x numericalgo.Vector) error {
+	if !x.AreDimsEqual(a.mean) {
+		return fmt.Errorf("Dimensions must match")
+	}
+
+	a.n++
+	d := make(numericalgo.Vector, len(x))
+	for i := range x {
+		d[i] = x[i] - a.mean[i]
+		a.mean[i] += d[i] / float64(a.n)
+	}
+
+	for i := range a.c {
+		for j := range a.c[i] {
+			a.c[i][j] += d[i] * (x[j] - a.mean[j])
+		}
+	}
+	return nil
+}
+
+// Merge receives another accumulator and adds all of its observations to this accumulator, and returns the error
+// (if there is any). The other accumulator is left unchanged.
+func (a *CovarianceAccumulator) Merge(b *CovarianceAccumulator) error {
+	if !a.mean.AreDimsEqual(b.mean) {
+		return fmt.Errorf("Dimensions must match")
+	}
+
+	if b.n == 0 {
+		return nil
+	}
+
+	n := a.n + b.n
+	w := float64(a.n) * float64(b.n) / float64(n)
+	d := make(numericalgo.Vector, len(a.mean))
+	for i := range d {
+		d[i] = b.mean[i] - a.mean[i]
+	}
+
+	for i := range a.c {
+		for j := range a.c[i] {
+			a.c[i][j] += b.c[i][j] + d[i]*d[j]*w
+		}
+	}
+
+	for i := range a.mean {
+		a.mean[i] += d[i] * float64(b.n) / float64(n)
+	}
+	a.n = n
+	return nil
+}
+
+// Count returns the number of accumulated observations.
+func (a *CovarianceAccumulator) Count() int {
+	return a.n
+}
+
+// Mean returns the vector of means of the variables and the error (if there is any).
+func (a *CovarianceAccumulator) Mean() (numericalgo.Vector, error) {
+	if a.n == 0 {
+		return nil, fmt.Errorf("No samples have been added")
+	}
+
+	r := make(numericalgo.Vector, len(a.mean))
+	copy(r, a.mean)
+	return r, nil
+}
+
+// Covariance receives the delta degrees of freedom. It returns the covariance matrix of the variables and the error
+// (if there is any).
+func (a *CovarianceAccumulator) Covariance(ddof int) (numericalgo.Matrix, error) {
+	if a.n-ddof <= 0 || ddof < 0 {
+		return nil, fmt.Errorf("Not enough observations for the given degrees of freedom")
+	}
+
+	r := make(numericalgo.Matrix, len(a.c))
+	for i := range a.c {
+		r[i] = make(numericalgo.Vector, len(a.c[i]))
+		for j := range a.c[i] {
+			r[i][j] = a.c[i][j] / float64(a.n-ddof)
+		}
+	}
+	return r, nil
+}
+
+// P2Quantile estimates a quantile of a stream of values with the P-square algorithm of Jain and Chlamtac, which keeps
+// only five markers instead of the samples. The estimate is exact for up to five samples and converges to the true
+// quantile for continuous distributions. It is not safe for concurrent use. Instead, every goroutine should estimate
+// with its own P2Quantile, and the results should be combined with Merge, which is approximate.
+type P2Quantile struct {
+	p       float64
+	count   int
+	q       [5]float64
+	pos     [5]float64
+	desired [5]float64
+	inc     [5]float64
+}
+
+// NewP2Quantile receives the probability p in (0, 1) of the quantile to estimate. It returns the pointer to the new
+// P2Quantile estimator and the error (if there is any).
+func NewP2Quantile(p float64) (*P2Quantile, error) {
+	if p <= 0 || p >= 1 || math.IsNaN(p) {
+		return nil, fmt.Errorf("Probability must be between 0 and 1")
+	}
+
+	return &P2Quantile{
+		p:       p,
+		pos:     [5]float64{0, 1, 2, 3, 4},
+		desired: [5]float64{0, 2 * p, 4 * p, 2 + 2*p, 4},
+		inc:     [5]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}, nil
+}
+
+// Add receives a sample and updates the estimate.
+func (e *P2Quantile) Add(x float64) {
+	if e.count < 5 {
+		e.q[e.count] = x
+		e.count++
+		if e.count == 5 {
+			sort.Float64s(e.q[:])
+		}
+		return
+	}
+	e.count++
+
+	var k int
+	switch {
+	case x < e.q[0]:
+		e.q[0] = x
+		k = 0
+	case x >= e.q[4]:
+		e.q[4] = x
+		k = 3
+	default:
+		for k = 0; k < 3 && x >= e.q[k+1]; k++ {
+		}
+	}
+
+	for i := k + 1; i < 5; i++ {
+		e.pos[i]++
+	}
+	for i := range e.desired {
+		e.desired[i] += e.inc[i]
+	}
+
+	for i := 1; i < 4; i++ {
+		d := e.desired[i] - e.pos[i]
+		if d >= 1 && e.pos[i+1]-e.pos[i] > 1 || d <= -1 && e.pos[i-1]-e.pos[i] < -1 {
+			s := math.Copysign(1, d)
+			q := e.parabolic(i, s)
+			if e.q[i-1] < q && q < e.q[i+1] {
+				e.q[i] = q
+			} else {
+				j := i + int(s)
+				e.q[i] += s * (e.q[j] - e.q[i]) / (e.pos[j] - e.pos[i])
+			}
+			e.pos[i] += s
+		}
+	}
+}
+
+// Merge receives another estimator of the same quantile and combines its samples into this estimator, and returns
+// the error (if there is any). The other estimator is left unchanged. Every estimator approximates the cumulative
+// distribution of its samples by linear interpolation between its markers; the markers of the result are placed at
+// their desired positions on the count-weighted mixture of the two approximations. Unlike Moments.Merge, the result
+// is not identical to adding the samples one by one, but it has the same accuracy for large streams. Estimators with
+// fewer than five samples still hold the samples themselves, which are then added exactly.
+func (e *P2Quantile) Merge(b *P2Quantile) error {
+	if e.p != b.p {
+		return fmt.Errorf("Probabilities must match")
+	}
+
+	if b.count < 5 {
+		// The samples are copied first, as b may be e itself.
+		samples := make([]float64, b.count)
+		copy(samples, b.q[:b.count])
+		for _, x := range samples {
+			e.Add(x)
+		}
+		return nil
+	}
+	if e.count < 5 {
+		samples := make([]float64, e.count)
+		copy(samples, e.q[:e.count])
+		*e = *b
+		for _, x := range samples {
+			e.Add(x)
+		}
+		return nil
+	}
+
+	n := e.count + b.count
+	wa := float64(e.count) / float64(n)
+	wb := float64(b.count) / float64(n)
+	cdf := func(x float64) float64 {
+		return wa*e.markerCDF(x) + wb*b.markerCDF(x)
+	}
+
+	// The mixture is linear between the union of the marker heights, so it can be inverted exactly on that grid.
+	xs := make([]float64, 0, 10)
+	xs = append(xs, e.q[:]...)
+	xs = append(xs, b.q[:]...)
+	sort.Float64s(xs)
+
+	var merged P2Quantile
+	merged.p = e.p
+	merged.count = n
+	merged.inc = e.inc
+	last := float64(n - 1)
+	for i := range merged.desired {
+		merged.desired[i] = last * merged.inc[i]
+		merged.pos[i] = math.Round(merged.desired[i])
+		if i > 0 && merged.pos[i] <= merged.pos[i-1] {
+			merged.pos[i] = merged.pos[i-1] + 1
+		}
+	}
+	for i := 3; i > 0; i-- {
+		if merged.pos[i] >= merged.pos[i+1] {
+			merged.pos[i] = merged.pos[i+1] - 1
+		}
+	}
+
+	merged.q[0] = xs[0]
+	merged.q[4] = xs[len(xs)-1]
+	for i := 1; i < 4; i++ {
+		target := merged.pos[i] / last
+		merged.q[i] = xs[len(xs)-1]
+		for k := 1; k < len(xs); k++ {
+			lo, hi := cdf(xs[k-1]), cdf(xs[k])
+			if hi >= target {
+				merged.q[i] = xs[k]
+				if hi > lo {
+					merged.q[i] = xs[k-1] + (target-lo)/(hi-lo)*(xs[k]-xs[k-1])
+				}
+				break
+			}
+		}
+	}
+
+	*e = merged
+	return nil
+}
+
+// markerCDF returns the fraction of the samples below x, interpolated linearly between the markers.
+func (e *P2Quantile) markerCDF(x float64) float64 {
+	last := float64(e.count - 1)
+	if x < e.q[0] {
+		return 0
+	}
+	if x >= e.q[4] {
+		return 1
+	}
+	for i := 1; i < 5; i++ {
+		if x < e.q[i] {
+			frac := (x - e.q[i-1]) / (e.q[i] - e.q[i-1])
+			return (e.pos[i-1] + frac*(e.pos[i]-e.pos[i-1])) / last
+		}
+	}
+	return 1
+}
+
+// parabolic returns the piecewise-parabolic prediction of marker i moved by s positions.
+func (e *P2Quantile) parabolic(i int, s float64) float64 {
+	q, n := e.q, e.pos
+	return q[i] + s/(n[i+1]-n[i-1])*((n[i]-n[i-1]+s)*(q[i+1]-q[i])/(n[i+1]-n[i])+
+		(n[i+1]-n[i]-s)*(q[i]-q[i-1])/(n[i]-n[i-1]))
+}
+
+// Count returns the number of accumulated samples.
+func (e *P2Quantile) Count() int {
+	return e.count
+}
+
+// Value returns the current estimate of the quantile and the error (if there is any).
+func (e *P2Quantile) Value() (float64, error) {
+	if e.count == 0 {
+		return 0, fmt.Errorf("No samples have been added")
+	}
+
+	if e.count < 5 {
+		return Quantile(numericalgo.Vector(e.q[:e.count]), e.p, Linear)
+	}
+	return e.q[2], nil
+}
@@ -0,0 +1,355 @@
+package stats_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoments(t *testing.T) {
+	v := numericalgo.Vector{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+
+	a := stats.NewMoments()
+	for _, val := range v {
+		a.Add(val)
+	}
+
+	mean, err := a.Mean()
+	assert.Equal(t, 1e9+10, mean)
+	assert.Equal(t, nil, err)
+
+	variance, err := a.Variance(1)
+	assert.Equal(t, 30.0, variance)
+	assert.Equal(t, nil, err)
+
+	min, err := a.Min()
+	assert.Equal(t, 1e9+4, min)
+	assert.Equal(t, nil, err)
+
+	max, err := a.Max()
+	assert.Equal(t, 1e9+16, max)
+	assert.Equal(t, nil, err)
+
+	assert.Equal(t, 4, a.Count())
+}
+
+func TestMomentsEmpty(t *testing.T) {
+	a := stats.NewMoments()
+
+	_, err := a.Mean()
+	assert.Equal(t, fmt.Errorf("No samples have been added"), err)
+
+	_, err = a.Variance(1)
+	assert.Equal(t, fmt.Errorf("Not enough elements for the given degrees of freedom"), err)
+}
+
+func TestMomentsZeroValue(t *testing.T) {
+	cases := map[string]struct {
+		first       numericalgo.Vector
+		second      numericalgo.Vector
+		expectedMin float64
+		expectedMax float64
+	}{
+		"all positive": {
+			first:       numericalgo.Vector{3, 5, 4},
+			second:      numericalgo.Vector{},
+			expectedMin: 3,
+			expectedMax: 5,
+		},
+		"all negative": {
+			first:       numericalgo.Vector{-3, -5, -4},
+			second:      numericalgo.Vector{},
+			expectedMin: -5,
+			expectedMax: -3,
+		},
+		"merged into an empty accumulator": {
+			first:       numericalgo.Vector{},
+			second:      numericalgo.Vector{2, 7},
+			expectedMin: 2,
+			expectedMax: 7,
+		},
+		"merged with an empty accumulator": {
+			first:       numericalgo.Vector{-2, -7},
+			second:      numericalgo.Vector{},
+			expectedMin: -7,
+			expectedMax: -2,
+		},
+		"merged non-empty accumulators": {
+			first:       numericalgo.Vector{1, 2},
+			second:      numericalgo.Vector{8, 9},
+			expectedMin: 1,
+			expectedMax: 9,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var a, b stats.Moments
+			for _, val := range c.first {
+				a.Add(val)
+			}
+			for _, val := range c.second {
+				b.Add(val)
+			}
+			a.Merge(&b)
+
+			min, err := a.Min()
+			assert.Equal(t, c.expectedMin, min)
+			assert.Equal(t, nil, err)
+
+			max, err := a.Max()
+			assert.Equal(t, c.expectedMax, max)
+			assert.Equal(t, nil, err)
+
+			assert.Equal(t, len(c.first)+len(c.second), a.Count())
+		})
+	}
+}
+
+func TestMomentsMerge(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	v := make(numericalgo.Vector, 1000)
+	for i := range v {
+		v[i] = src.NormFloat64()*3 + 10
+	}
+
+	parts := make([]*stats.Moments, 4)
+	var wg sync.WaitGroup
+	for p := range parts {
+		parts[p] = stats.NewMoments()
+		wg.Add(1)
+		go func(a *stats.Moments, chunk numericalgo.Vector) {
+			defer wg.Done()
+			for _, val := range chunk {
+				a.Add(val)
+			}
+		}(parts[p], v[p*250:(p+1)*250])
+	}
+	wg.Wait()
+
+	total := stats.NewMoments()
+	for _, a := range parts {
+		total.Merge(a)
+	}
+
+	expectedMean, _ := stats.Mean(v)
+	expectedVariance, _ := stats.Variance(v, 1)
+	expectedMin, _ := v.Min()
+
+	mean, _ := total.Mean()
+	variance, _ := total.Variance(1)
+	min, _ := total.Min()
+
+	assert.Equal(t, 1000, total.Count())
+	assert.InEpsilon(t, expectedMean, mean, 1e-13)
+	assert.InEpsilon(t, expectedVariance, variance, 1e-12)
+	assert.Equal(t, expectedMin, min)
+}
+
+func TestCovarianceAccumulator(t *testing.T) {
+	data := numericalgo.Matrix{{1, 2}, {2, 4}, {3, 7}, {4, 1}, {5, 5}}
+	expected, _ := stats.Covariance(data, 1)
+
+	whole := stats.NewCovarianceAccumulator(2)
+	first := stats.NewCovarianceAccumulator(2)
+	second := stats.NewCovarianceAccumulator(2)
+	for i, row := range data {
+		assert.Equal(t, nil, whole.Add(row))
+		if i < 2 {
+			assert.Equal(t, nil, first.Add(row))
+		} else {
+			assert.Equal(t, nil, second.Add(row))
+		}
+	}
+	assert.Equal(t, nil, first.Merge(second))
+
+	for _, a := range []*stats.CovarianceAccumulator{whole, first} {
+		cov, err := a.Covariance(1)
+		assert.Equal(t, nil, err)
+		assert.True(t, cov.IsSimilar(expected, 1e-12))
+
+		mean, err := a.Mean()
+		assert.Equal(t, nil, err)
+		assert.True(t, mean.IsSimilar(numericalgo.Vector{3, 3.8}, 1e-12))
+	}
+
+	assert.Equal(t, fmt.Errorf("Dimensions must match"), whole.Add(numericalgo.Vector{1}))
+}
+
+func TestP2Quantile(t *testing.T) {
+	cases := map[string]struct {
+		p             float64
+		expectedValue float64
+	}{
+		"median": {
+			p:             0.5,
+			expectedValue: 0,
+		},
+		"90th percentile": {
+			p:             0.9,
+			expectedValue: 1.2815515655446004,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			src := rand.New(rand.NewSource(42))
+			e, err := stats.NewP2Quantile(c.p)
+			assert.Equal(t, nil, err)
+
+			for i := 0; i < 100000; i++ {
+				e.Add(src.NormFloat64())
+			}
+
+			result, err := e.Value()
+			assert.InDelta(t, c.expectedValue, result, 0.02)
+			assert.Equal(t, nil, err)
+		})
+	}
+}
+
+func TestP2QuantileMerge(t *testing.T) {
+	cases := map[string]struct {
+		p             float64
+		parts         int
+		expectedValue float64
+	}{
+		"median of two parts": {
+			p:             0.5,
+			parts:         2,
+			expectedValue: 0,
+		},
+		"90th percentile of eight parts": {
+			p:             0.9,
+			parts:         8,
+			expectedValue: 1.2815515655446004,
+		},
+		"10th percentile of four parts": {
+			p:             0.1,
+			parts:         4,
+			expectedValue: -1.2815515655446004,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			src := rand.New(rand.NewSource(7))
+			v := make([]float64, 100000)
+			for i := range v {
+				v[i] = src.NormFloat64()
+			}
+
+			single, _ := stats.NewP2Quantile(c.p)
+			for _, val := range v {
+				single.Add(val)
+			}
+			expected, _ := single.Value()
+
+			parts := make([]*stats.P2Quantile, c.parts)
+			var wg sync.WaitGroup
+			size := len(v) / c.parts
+			for p := range parts {
+				parts[p], _ = stats.NewP2Quantile(c.p)
+				wg.Add(1)
+				go func(e *stats.P2Quantile, chunk []float64) {
+					defer wg.Done()
+					for _, val := range chunk {
+						e.Add(val)
+					}
+				}(parts[p], v[p*size:(p+1)*size])
+			}
+			wg.Wait()
+
+			merged, _ := stats.NewP2Quantile(c.p)
+			for _, e := range parts {
+				assert.Equal(t, nil, merged.Merge(e))
+			}
+			assert.Equal(t, len(v), merged.Count())
+
+			result, err := merged.Value()
+			assert.Equal(t, nil, err)
+			assert.InDelta(t, expected, result, 0.02)
+			assert.InDelta(t, c.expectedValue, result, 0.03)
+		})
+	}
+}
+
+func TestP2QuantileMergeFewSamples(t *testing.T) {
+	a, _ := stats.NewP2Quantile(0.5)
+	b, _ := stats.NewP2Quantile(0.5)
+	for _, val := range []float64{5, 1} {
+		a.Add(val)
+	}
+	for _, val := range []float64{3, 9, 7} {
+		b.Add(val)
+	}
+
+	assert.Equal(t, nil, a.Merge(b))
+	result, err := a.Value()
+	assert.Equal(t, 5.0, result)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 5, a.Count())
+
+	c, _ := stats.NewP2Quantile(0.9)
+	assert.Equal(t, fmt.Errorf("Probabilities must match"), a.Merge(c))
+}
+
+func TestP2QuantileMergeSelf(t *testing.T) {
+	cases := map[string]struct {
+		samples []float64
+		delta   float64
+	}{
+		"few samples": {
+			samples: []float64{9, 1, 5, 7},
+			delta:   0,
+		},
+		"many samples": {
+			samples: []float64{5, 1, 3, 9, 7, 2, 8, 4, 6},
+			delta:   1,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, _ := stats.NewP2Quantile(0.5)
+			expected, _ := stats.NewP2Quantile(0.5)
+			for _, val := range c.samples {
+				e.Add(val)
+				expected.Add(val)
+			}
+			for _, val := range c.samples {
+				expected.Add(val)
+			}
+
+			assert.Equal(t, nil, e.Merge(e))
+			assert.Equal(t, expected.Count(), e.Count())
+			result, err := e.Value()
+			expectedValue, _ := expected.Value()
+			assert.InDelta(t, expectedValue, result, c.delta)
+			assert.Equal(t, nil, err)
+		})
+	}
+}
+
+func TestP2QuantileFewSamples(t *testing.T) {
+	e, err := stats.NewP2Quantile(0.5)
+	assert.Equal(t, nil, err)
+
+	_, err = e.Value()
+	assert.Equal(t, fmt.Errorf("No samples have been added"), err)
+
+	for _, val := range []float64{5, 1, 3} {
+		e.Add(val)
+	}
+	result, err := e.Value()
+	assert.Equal(t, 3.0, result)
+	assert.Equal(t, nil, err)
+
+	_, err = stats.NewP2Quantile(1)
+	assert.Equal(t, fmt.Errorf("Probability must be between 0 and 1"), err)
+}
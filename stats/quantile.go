@@ -0,0 +1,106 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// QuantileMethod selects how a quantile which falls between two data points is computed.
+type QuantileMethod int
+
+const (
+	// Linear interpolates linearly between the two neighboring order statistics, at position p*(n-1). This is the
+	// default of numpy and R (type 7).
+	Linear QuantileMethod = iota
+	// Lower returns the smaller of the two neighboring order statistics.
+	Lower
+	// Higher returns the larger of the two neighboring order statistics.
+	Higher
+	// Nearest returns the closer of the two neighboring order statistics, rounding half to even.
+	Nearest
+	// Midpoint returns the average of the two neighboring order statistics.
+	Midpoint
+	// Weibull interpolates linearly at position p*(n+1) - 1 (R type 6), which is unbiased for the uniform
+	// distribution.
+	Weibull
+	// Hazen interpolates linearly at position p*n - 0.5 (R type 5).
+	Hazen
+)
+
+// Median receives a vector and returns the median of its elements, and the error (if there is any).
+func Median(v numericalgo.Vector) (float64, error) {
+	return Quantile(v, 0.5, Linear)
+}
+
+// Quantile receives a vector, the probability p in [0, 1] and the interpolation method. It returns the p-th quantile
+// of the elements and the error (if there is any).
+func Quantile(v numericalgo.Vector, p float64, method QuantileMethod) (float64, error) {
+	q, err := Quantiles(v, numericalgo.Vector{p}, method)
+	if err != nil {
+		return 0, err
+	}
+	return q[0], nil
+}
+
+// Quantiles receives a vector, the vector of probabilities in [0, 1] and the interpolation method. It returns the
+// quantiles of the elements for all the probabilities, sorting the data only once, and the error (if there is any).
+func Quantiles(v, ps numericalgo.Vector, method QuantileMethod) (numericalgo.Vector, error) {
+	n := v.Dim()
+	if n == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+
+	for _, val := range v {
+		if math.IsNaN(val) {
+			return nil, fmt.Errorf("Vector cannot contain NaN")
+		}
+	}
+
+	sorted := make(numericalgo.Vector, n)
+	copy(sorted, v)
+	sort.Float64s(sorted)
+
+	var r numericalgo.Vector
+	for _, p := range ps {
+		if p < 0 || p > 1 || math.IsNaN(p) {
+			return nil, fmt.Errorf("Probability must be between 0 and 1")
+		}
+
+		var pos float64
+		switch method {
+		case Weibull:
+			pos = p*float64(n+1) - 1
+		case Hazen:
+			pos = p*float64(n) - 0.5
+		case Linear, Lower, Higher, Nearest, Midpoint:
+			pos = p * float64(n-1)
+		default:
+			return nil, fmt.Errorf("Unknown quantile method")
+		}
+
+		pos = math.Max(0, math.Min(float64(n-1), pos))
+		lo := int(math.Floor(pos))
+		hi := int(math.Ceil(pos))
+		frac := pos - float64(lo)
+
+		var q float64
+		switch method {
+		case Lower:
+			q = sorted[lo]
+		case Higher:
+			q = sorted[hi]
+		case Nearest:
+			q = sorted[int(math.RoundToEven(pos))]
+		case Midpoint:
+			q = (sorted[lo] + sorted[hi]) / 2
+		default:
+			q = sorted[lo] + frac*(sorted[hi]-sorted[lo])
+		}
+		r = append(r, q)
+	}
+
+	return r, nil
+}
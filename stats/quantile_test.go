@@ -0,0 +1,131 @@
+package stats_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuantile(t *testing.T) {
+	cases := map[string]struct {
+		vector        numericalgo.Vector
+		p             float64
+		method        stats.QuantileMethod
+		expectedValue float64
+		expectedError error
+	}{
+		"linear": {
+			vector:        numericalgo.Vector{4, 1, 3, 2},
+			p:             0.4,
+			method:        stats.Linear,
+			expectedValue: 2.2,
+			expectedError: nil,
+		},
+		"lower": {
+			vector:        numericalgo.Vector{4, 1, 3, 2},
+			p:             0.4,
+			method:        stats.Lower,
+			expectedValue: 2,
+			expectedError: nil,
+		},
+		"higher": {
+			vector:        numericalgo.Vector{4, 1, 3, 2},
+			p:             0.4,
+			method:        stats.Higher,
+			expectedValue: 3,
+			expectedError: nil,
+		},
+		"nearest": {
+			vector:        numericalgo.Vector{4, 1, 3, 2},
+			p:             0.4,
+			method:        stats.Nearest,
+			expectedValue: 2,
+			expectedError: nil,
+		},
+		"midpoint": {
+			vector:        numericalgo.Vector{4, 1, 3, 2},
+			p:             0.4,
+			method:        stats.Midpoint,
+			expectedValue: 2.5,
+			expectedError: nil,
+		},
+		"weibull": {
+			vector:        numericalgo.Vector{4, 1, 3, 2},
+			p:             0.4,
+			method:        stats.Weibull,
+			expectedValue: 2,
+			expectedError: nil,
+		},
+		"hazen": {
+			vector:        numericalgo.Vector{4, 1, 3, 2},
+			p:             0.4,
+			method:        stats.Hazen,
+			expectedValue: 2.1,
+			expectedError: nil,
+		},
+		"extreme probability": {
+			vector:        numericalgo.Vector{4, 1, 3, 2},
+			p:             1,
+			method:        stats.Weibull,
+			expectedValue: 4,
+			expectedError: nil,
+		},
+		"invalid probability": {
+			vector:        numericalgo.Vector{4, 1, 3, 2},
+			p:             1.5,
+			method:        stats.Linear,
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Probability must be between 0 and 1"),
+		},
+		"empty vector": {
+			vector:        numericalgo.Vector{},
+			p:             0.5,
+			method:        stats.Linear,
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Vector cannot be empty"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.Quantile(c.vector, c.p, c.method)
+			assert.InDelta(t, c.expectedValue, result, 1e-12)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	cases := map[string]struct {
+		vector        numericalgo.Vector
+		expectedValue float64
+	}{
+		"odd number of elements": {
+			vector:        numericalgo.Vector{5, 1, 3},
+			expectedValue: 3,
+		},
+		"even number of elements": {
+			vector:        numericalgo.Vector{5, 1, 3, 2},
+			expectedValue: 2.5,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.Median(c.vector)
+			assert.Equal(t, c.expectedValue, result)
+			assert.Equal(t, nil, err)
+		})
+	}
+}
+
+func TestQuantiles(t *testing.T) {
+	v := numericalgo.Vector{1, 2, 3, 4, 5}
+	result, err := stats.Quantiles(v, numericalgo.Vector{0, 0.25, 0.5, 0.75, 1}, stats.Linear)
+	assert.Equal(t, numericalgo.Vector{1, 2, 3, 4, 5}, result)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, numericalgo.Vector{1, 2, 3, 4, 5}, v)
+}
@@ -0,0 +1,109 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// ShapiroWilk receives a sample of 3 to 5000 elements. It performs the Shapiro-Wilk test of normality, and returns
+// the W statistic and its p-value, and the error (if there is any). Small p-values indicate that the sample is
+// unlikely to come from a normal distribution. The coefficients and the p-value are computed with the
+// approximations of Royston (algorithm AS R94).
+func ShapiroWilk(v numericalgo.Vector) (TestResult, error) {
+	n := v.Dim()
+	if n < 3 || n > 5000 {
+		return TestResult{}, fmt.Errorf("Sample must have between 3 and 5000 elements")
+	}
+
+	x := sortedCopy(v)
+	if x[0] == x[n-1] {
+		return TestResult{}, fmt.Errorf("Sample variance cannot be zero")
+	}
+
+	a := shapiroWilkCoefficients(n)
+
+	mean, err := Mean(x)
+	if err != nil {
+		return TestResult{}, err
+	}
+
+	var num, ss float64
+	for i, val := range x {
+		num += a[i] * val
+		ss += (val - mean) * (val - mean)
+	}
+	w := math.Min(1, num*num/ss)
+
+	return TestResult{Statistic: w, PValue: shapiroWilkPValue(w, n)}, nil
+}
+
+// shapiroWilkCoefficients returns Royston's approximation of the coefficients of the ordered sample.
+func shapiroWilkCoefficients(n int) numericalgo.Vector {
+	a := make(numericalgo.Vector, n)
+	if n == 3 {
+		a[0], a[2] = -math.Sqrt(0.5), math.Sqrt(0.5)
+		return a
+	}
+
+	norm := standardNormal()
+	fn := float64(n)
+	m := make(numericalgo.Vector, n)
+	var mm float64
+	for i := range m {
+		m[i] = norm.Quantile((float64(i+1) - 0.375) / (fn + 0.25))
+		mm += m[i] * m[i]
+	}
+
+	u := 1 / math.Sqrt(fn)
+	an := m[n-1]/math.Sqrt(mm) + poly(u, 0, 0.221157, -0.147981, -2.071190, 4.434685, -2.706056)
+
+	var phi float64
+	first := 1
+	if n > 5 {
+		an1 := m[n-2]/math.Sqrt(mm) + poly(u, 0, 0.042981, -0.293762, -1.752461, 5.682633, -3.582633)
+		phi = (mm - 2*m[n-1]*m[n-1] - 2*m[n-2]*m[n-2]) / (1 - 2*an*an - 2*an1*an1)
+		a[1], a[n-2] = -an1, an1
+		first = 2
+	} else {
+		phi = (mm - 2*m[n-1]*m[n-1]) / (1 - 2*an*an)
+	}
+	a[0], a[n-1] = -an, an
+
+	for i := first; i < n-first; i++ {
+		a[i] = m[i] / math.Sqrt(phi)
+	}
+	return a
+}
+
+// shapiroWilkPValue returns Royston's normalizing approximation of the p-value of W.
+func shapiroWilkPValue(w float64, n int) float64 {
+	fn := float64(n)
+	if n == 3 {
+		return math.Max(0, 6/math.Pi*(math.Asin(math.Sqrt(w))-math.Asin(math.Sqrt(0.75))))
+	}
+
+	var z float64
+	if n <= 11 {
+		gamma := poly(fn, -2.273, 0.459)
+		mu := poly(fn, 0.5440, -0.39978, 0.025054, -0.0006714)
+		sigma := math.Exp(poly(fn, 1.3822, -0.77857, 0.062767, -0.0020322))
+		z = (-math.Log(gamma-math.Log1p(-w)) - mu) / sigma
+	} else {
+		ln := math.Log(fn)
+		mu := poly(ln, -1.5861, -0.31082, -0.083751, 0.0038915)
+		sigma := math.Exp(poly(ln, -0.4803, -0.082676, 0.0030302))
+		z = (math.Log1p(-w) - mu) / sigma
+	}
+	return standardNormal().Survival(z)
+}
+
+// poly evaluates the polynomial with the coefficients c (in the order of increasing powers) at x.
+func poly(x float64, c ...float64) float64 {
+	var r float64
+	for i := len(c) - 1; i >= 0; i-- {
+		r = r*x + c[i]
+	}
+	return r
+}
@@ -0,0 +1,58 @@
+package stats_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShapiroWilk(t *testing.T) {
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		expectedResult stats.TestResult
+		expectedError  error
+	}{
+		"skewed sample": {
+			// Weights of 11 men from the original paper of Shapiro and Wilk.
+			vector:         numericalgo.Vector{148, 154, 158, 160, 161, 162, 166, 170, 182, 195, 236},
+			expectedResult: stats.TestResult{Statistic: 0.78881, PValue: 0.006704},
+			expectedError:  nil,
+		},
+		"three elements": {
+			vector:         numericalgo.Vector{1, 2, 4},
+			expectedResult: stats.TestResult{Statistic: 0.9642857142857146, PValue: 0.6368868450289714},
+			expectedError:  nil,
+		},
+		"not enough elements": {
+			vector:         numericalgo.Vector{1, 2},
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Sample must have between 3 and 5000 elements"),
+		},
+		"constant sample": {
+			vector:         numericalgo.Vector{1, 1, 1},
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Sample variance cannot be zero"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.ShapiroWilk(c.vector)
+			assert.InDelta(t, c.expectedResult.Statistic, result.Statistic, 1e-5)
+			assert.InDelta(t, c.expectedResult.PValue, result.PValue, 1e-6)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestShapiroWilkNormal(t *testing.T) {
+	v := numericalgo.Vector{2.1, 3.4, 1.9, 5.6, 4.4, 3.3, 2.8, 3.9, 4.1, 3.0, 2.5, 3.7, 4.8, 3.2, 2.9, 3.5, 4.0, 3.1, 2.2, 3.8}
+
+	result, err := stats.ShapiroWilk(v)
+	assert.True(t, result.Statistic > 0.95)
+	assert.True(t, result.PValue > 0.5)
+	assert.Equal(t, nil, err)
+}
@@ -0,0 +1,118 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/distuv"
+)
+
+// TTest receives a sample, the hypothesized mean and the alternative hypothesis. It performs the one-sample
+// Student's t-test of whether the mean of the population equals mu, and returns the t statistic, its p-value and
+// n - 1 degrees of freedom, and the error (if there is any).
+func TTest(v numericalgo.Vector, mu float64, alt Alternative) (TestResult, error) {
+	mean, variance, err := meanVariance(v)
+	if err != nil {
+		return TestResult{}, err
+	}
+
+	n := float64(v.Dim())
+	return tTestResult((mean-mu)/math.Sqrt(variance/n), n-1, alt)
+}
+
+// TTest2 receives two independent samples and the alternative hypothesis. It performs the two-sample Student's
+// t-test of whether the populations have equal means, assuming equal variances, and returns the t statistic, its
+// p-value and n1 + n2 - 2 degrees of freedom, and the error (if there is any). Use WelchTTest when the variances may
+// differ.
+func TTest2(a, b numericalgo.Vector, alt Alternative) (TestResult, error) {
+	meanA, varA, err := meanVariance(a)
+	if err != nil {
+		return TestResult{}, err
+	}
+
+	meanB, varB, err := meanVariance(b)
+	if err != nil {
+		return TestResult{}, err
+	}
+
+	na, nb := float64(a.Dim()), float64(b.Dim())
+	dof := na + nb - 2
+	pooled := ((na-1)*varA + (nb-1)*varB) / dof
+	return tTestResult((meanA-meanB)/math.Sqrt(pooled*(1/na+1/nb)), dof, alt)
+}
+
+// WelchTTest receives two independent samples and the alternative hypothesis. It performs Welch's t-test of whether
+// the populations have equal means without assuming equal variances, and returns the t statistic, its p-value and
+// the Welch-Satterthwaite degrees of freedom, and the error (if there is any). One of the samples may be constant.
+func WelchTTest(a, b numericalgo.Vector, alt Alternative) (TestResult, error) {
+	meanA, varA, err := sampleMoments(a)
+	if err != nil {
+		return TestResult{}, err
+	}
+
+	meanB, varB, err := sampleMoments(b)
+	if err != nil {
+		return TestResult{}, err
+	}
+
+	if varA == 0 && varB == 0 {
+		return TestResult{}, fmt.Errorf("Sample variances cannot both be zero")
+	}
+
+	sa := varA / float64(a.Dim())
+	sb := varB / float64(b.Dim())
+	dof := (sa + sb) * (sa + sb) / (sa*sa/float64(a.Dim()-1) + sb*sb/float64(b.Dim()-1))
+	return tTestResult((meanA-meanB)/math.Sqrt(sa+sb), dof, alt)
+}
+
+// PairedTTest receives two paired samples and the alternative hypothesis. It performs the paired t-test of whether
+// the mean difference a - b is zero, and returns the t statistic, its p-value and n - 1 degrees of freedom, and the
+// error (if there is any).
+func PairedTTest(a, b numericalgo.Vector, alt Alternative) (TestResult, error) {
+	d, err := a.Subtract(b)
+	if err != nil {
+		return TestResult{}, err
+	}
+	return TTest(d, 0, alt)
+}
+
+// meanVariance returns the mean and the unbiased variance of a sample, which must have at least two elements and
+// must not be constant.
+func meanVariance(v numericalgo.Vector) (float64, float64, error) {
+	mean, variance, err := sampleMoments(v)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if variance == 0 {
+		return 0, 0, fmt.Errorf("Sample variance cannot be zero")
+	}
+	return mean, variance, nil
+}
+
+// sampleMoments returns the mean and the unbiased variance of a sample, which must have at least two elements.
+func sampleMoments(v numericalgo.Vector) (float64, float64, error) {
+	if v.Dim() < 2 {
+		return 0, 0, fmt.Errorf("Sample must have at least two elements")
+	}
+
+	mean, err := Mean(v)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	variance, err := Variance(v, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	return mean, variance, nil
+}
+
+func tTestResult(t, dof float64, alt Alternative) (TestResult, error) {
+	d, err := distuv.NewStudentsT(dof, nil)
+	if err != nil {
+		return TestResult{}, err
+	}
+	return TestResult{Statistic: t, PValue: tailProbability(t, d.CDF, d.Survival, alt), DoF: dof}, nil
+}
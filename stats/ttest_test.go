@@ -0,0 +1,167 @@
+package stats_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTest(t *testing.T) {
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		mu             float64
+		alt            stats.Alternative
+		expectedResult stats.TestResult
+		expectedError  error
+	}{
+		"two sided": {
+			vector:         numericalgo.Vector{5.1, 4.9, 5.6, 5.8, 6.0},
+			mu:             5,
+			alt:            stats.TwoSided,
+			expectedResult: stats.TestResult{Statistic: 2.304073731539131, PValue: 0.08256829674577404, DoF: 4},
+			expectedError:  nil,
+		},
+		"greater": {
+			vector:         numericalgo.Vector{5.1, 4.9, 5.6, 5.8, 6.0},
+			mu:             5,
+			alt:            stats.Greater,
+			expectedResult: stats.TestResult{Statistic: 2.304073731539131, PValue: 0.04128414837288702, DoF: 4},
+			expectedError:  nil,
+		},
+		"less": {
+			vector:         numericalgo.Vector{5.1, 4.9, 5.6, 5.8, 6.0},
+			mu:             5,
+			alt:            stats.Less,
+			expectedResult: stats.TestResult{Statistic: 2.304073731539131, PValue: 1 - 0.04128414837288702, DoF: 4},
+			expectedError:  nil,
+		},
+		"single element": {
+			vector:         numericalgo.Vector{1},
+			mu:             0,
+			alt:            stats.TwoSided,
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Sample must have at least two elements"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.TTest(c.vector, c.mu, c.alt)
+			assert.InDelta(t, c.expectedResult.Statistic, result.Statistic, 1e-12)
+			assert.InDelta(t, c.expectedResult.PValue, result.PValue, 1e-12)
+			assert.Equal(t, c.expectedResult.DoF, result.DoF)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestTTest2(t *testing.T) {
+	cases := map[string]struct {
+		a              numericalgo.Vector
+		b              numericalgo.Vector
+		expectedResult stats.TestResult
+		expectedError  error
+	}{
+		"different means": {
+			a:              numericalgo.Vector{5.1, 4.9, 5.6, 5.8, 6.0},
+			b:              numericalgo.Vector{4.2, 4.8, 5.0, 4.4, 4.6},
+			expectedResult: stats.TestResult{Statistic: 3.494926471473966, PValue: 0.00813939659669094, DoF: 8},
+			expectedError:  nil,
+		},
+		"constant samples": {
+			a:              numericalgo.Vector{1, 2},
+			b:              numericalgo.Vector{3, 3},
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Sample variance cannot be zero"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.TTest2(c.a, c.b, stats.TwoSided)
+			assert.InDelta(t, c.expectedResult.Statistic, result.Statistic, 1e-12)
+			assert.InDelta(t, c.expectedResult.PValue, result.PValue, 1e-12)
+			assert.Equal(t, c.expectedResult.DoF, result.DoF)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestWelchTTest(t *testing.T) {
+	cases := map[string]struct {
+		a              numericalgo.Vector
+		b              numericalgo.Vector
+		expectedResult stats.TestResult
+		expectedError  error
+	}{
+		"different means": {
+			a:              numericalgo.Vector{5.1, 4.9, 5.6, 5.8, 6.0},
+			b:              numericalgo.Vector{4.2, 4.8, 5.0, 4.4, 4.6},
+			expectedResult: stats.TestResult{Statistic: 3.494926471473967, PValue: 0.009968226937123887, DoF: 7.040866016220284},
+			expectedError:  nil,
+		},
+		"one constant sample": {
+			a:              numericalgo.Vector{5, 5, 5},
+			b:              numericalgo.Vector{4.2, 4.8, 5.0, 4.4, 4.6},
+			expectedResult: stats.TestResult{Statistic: 2.8284271247461903, PValue: 0.04742065558431961, DoF: 4},
+			expectedError:  nil,
+		},
+		"both samples constant": {
+			a:              numericalgo.Vector{5, 5, 5},
+			b:              numericalgo.Vector{4, 4},
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Sample variances cannot both be zero"),
+		},
+		"single element": {
+			a:              numericalgo.Vector{1, 2},
+			b:              numericalgo.Vector{3},
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Sample must have at least two elements"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.WelchTTest(c.a, c.b, stats.TwoSided)
+			assert.InDelta(t, c.expectedResult.Statistic, result.Statistic, 1e-12)
+			assert.InDelta(t, c.expectedResult.PValue, result.PValue, 1e-12)
+			assert.InDelta(t, c.expectedResult.DoF, result.DoF, 1e-12)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestPairedTTest(t *testing.T) {
+	cases := map[string]struct {
+		a              numericalgo.Vector
+		b              numericalgo.Vector
+		expectedResult stats.TestResult
+		expectedError  error
+	}{
+		"different means": {
+			a:              numericalgo.Vector{5.1, 4.9, 5.6, 5.8, 6.0},
+			b:              numericalgo.Vector{4.2, 4.8, 5.0, 4.4, 4.6},
+			expectedResult: stats.TestResult{Statistic: 3.5513909739935525, PValue: 0.02376888366657426, DoF: 4},
+			expectedError:  nil,
+		},
+		"dimensions do not match": {
+			a:              numericalgo.Vector{1, 2},
+			b:              numericalgo.Vector{3},
+			expectedResult: stats.TestResult{},
+			expectedError:  fmt.Errorf("Dimensions must match"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.PairedTTest(c.a, c.b, stats.TwoSided)
+			assert.InDelta(t, c.expectedResult.Statistic, result.Statistic, 1e-12)
+			assert.InDelta(t, c.expectedResult.PValue, result.PValue, 1e-12)
+			assert.Equal(t, c.expectedResult.DoF, result.DoF)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Variance receives a vector and the delta degrees of freedom. It returns the variance sum((x_i - mean)^2) / (n - ddof)
+// of the elements, and the error (if there is any). Use ddof = 0 for the population variance and ddof = 1 for the
+// unbiased sample variance. The variance is computed with the corrected two-pass algorithm, which does not suffer
+// from the cancellation of the textbook formula mean(x^2) - mean(x)^2.
+func Variance(v numericalgo.Vector, ddof int) (float64, error) {
+	n := v.Dim()
+	if n-ddof <= 0 || ddof < 0 {
+		return 0, fmt.Errorf("Not enough elements for the given degrees of freedom")
+	}
+
+	mean, err := Mean(v)
+	if err != nil {
+		return 0, err
+	}
+
+	var sumSq, sum float64
+	for _, val := range v {
+		d := val - mean
+		sumSq += d * d
+		sum += d
+	}
+
+	return (sumSq - sum*sum/float64(n)) / float64(n-ddof), nil
+}
+
+// StdDev receives a vector and the delta degrees of freedom. It returns the standard deviation of the elements (the
+// square root of Variance), and the error (if there is any).
+func StdDev(v numericalgo.Vector, ddof int) (float64, error) {
+	variance, err := Variance(v, ddof)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
@@ -0,0 +1,58 @@
+package stats_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariance(t *testing.T) {
+	cases := map[string]struct {
+		vector        numericalgo.Vector
+		ddof          int
+		expectedValue float64
+		expectedError error
+	}{
+		"population variance": {
+			vector:        numericalgo.Vector{2, 4, 4, 4, 5, 5, 7, 9},
+			ddof:          0,
+			expectedValue: 4,
+			expectedError: nil,
+		},
+		"sample variance": {
+			vector:        numericalgo.Vector{2, 4, 4, 4, 5, 5, 7, 9},
+			ddof:          1,
+			expectedValue: 32.0 / 7,
+			expectedError: nil,
+		},
+		"large offset does not cancel": {
+			vector:        numericalgo.Vector{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16},
+			ddof:          1,
+			expectedValue: 30,
+			expectedError: nil,
+		},
+		"not enough elements": {
+			vector:        numericalgo.Vector{1},
+			ddof:          1,
+			expectedValue: 0,
+			expectedError: fmt.Errorf("Not enough elements for the given degrees of freedom"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := stats.Variance(c.vector, c.ddof)
+			assert.Equal(t, c.expectedValue, result)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestStdDev(t *testing.T) {
+	result, err := stats.StdDev(numericalgo.Vector{2, 4, 4, 4, 5, 5, 7, 9}, 0)
+	assert.Equal(t, 2.0, result)
+	assert.Equal(t, nil, err)
+}
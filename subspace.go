@@ -0,0 +1,69 @@
+package numericalgo
+
+import "fmt"
+
+// Rank receives the tolerance as a parameter. It returns the numerical rank of the matrix computed from its
+// column-pivoted QR decomposition, and the error (if there is any). If the tolerance is not positive, a default
+// based on machine precision and the size of the matrix is used.
+func (m Matrix) Rank(tol float64) (int, error) {
+	qr, err := m.QRPivot()
+	if err != nil {
+		return 0, err
+	}
+	return qr.Rank(tol), nil
+}
+
+// Orth receives the tolerance as a parameter. It returns the matrix whose columns form an orthonormal basis for the
+// column space (range) of the matrix, and the error (if there is any). The number of columns equals the numerical
+// rank.
+func (m Matrix) Orth(tol float64) (Matrix, error) {
+	qr, err := m.QRPivot()
+	if err != nil {
+		return nil, err
+	}
+	return qr.Q().firstCols(qr.Rank(tol)), nil
+}
+
+// OrthComplement receives the tolerance as a parameter. It returns the matrix whose columns form an orthonormal basis
+// for the orthogonal complement of the column space of the matrix (the null space of its transpose), and the error
+// (if there is any).
+func (m Matrix) OrthComplement(tol float64) (Matrix, error) {
+	qr, err := m.QRPivot()
+	if err != nil {
+		return nil, err
+	}
+	return qr.FullQ().lastCols(qr.Rank(tol)), nil
+}
+
+// NullSpace receives the tolerance as a parameter. It returns the matrix whose columns form an orthonormal basis for
+// the null space of the matrix, such that A*N = 0, and the error (if there is any). A matrix with full column rank
+// has an empty null space, in which case the result has zero columns.
+func (m Matrix) NullSpace(tol float64) (Matrix, error) {
+	if m.isNil() {
+		return nil, fmt.Errorf("Matrix cannot be nil")
+	}
+
+	mT, err := m.Transpose()
+	if err != nil {
+		return nil, err
+	}
+	return mT.OrthComplement(tol)
+}
+
+func (m Matrix) firstCols(k int) Matrix {
+	r := make(Matrix, len(m))
+	for i := range m {
+		r[i] = make(Vector, k)
+		copy(r[i], m[i][:k])
+	}
+	return r
+}
+
+func (m Matrix) lastCols(k int) Matrix {
+	r := make(Matrix, len(m))
+	for i := range m {
+		r[i] = make(Vector, len(m[i])-k)
+		copy(r[i], m[i][k:])
+	}
+	return r
+}
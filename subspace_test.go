@@ -0,0 +1,101 @@
+package numericalgo_test
+
+import (
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func isOrthonormal(m numericalgo.Matrix) bool {
+	_, cols := m.Dim()
+	for i := 0; i < cols; i++ {
+		ci, _ := m.Col(i)
+		for j := 0; j < cols; j++ {
+			cj, _ := m.Col(j)
+			d, _ := ci.Dot(cj)
+			expected := 0.0
+			if i == j {
+				expected = 1
+			}
+			if d-expected > 1e-12 || expected-d > 1e-12 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestMatrixRank(t *testing.T) {
+	cases := map[string]struct {
+		matrix       numericalgo.Matrix
+		expectedRank int
+	}{
+		"identity": {
+			matrix:       numericalgo.Matrix{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}},
+			expectedRank: 3,
+		},
+		"collinear regressors": {
+			matrix:       numericalgo.Matrix{{1, 0.3, 0.6}, {1, 0.8, 1.6}, {1, 1.2, 2.4}, {1, 1.7, 3.4}},
+			expectedRank: 2,
+		},
+		"nearly collinear regressors": {
+			matrix:       numericalgo.Matrix{{1, 0.3, 0.6}, {1, 0.8, 1.6}, {1, 1.2, 2.4 + 1e-6}, {1, 1.7, 3.4}},
+			expectedRank: 3,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			rank, err := c.matrix.Rank(0)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, c.expectedRank, rank)
+		})
+	}
+}
+
+func TestMatrixSubspaces(t *testing.T) {
+	m := numericalgo.Matrix{
+		{1, 2, 3},
+		{2, 4, 6},
+		{1, 0, 1},
+		{0, 1, 1},
+	}
+
+	orth, err := m.Orth(0)
+	assert.Equal(t, nil, err)
+	rows, cols := orth.Dim()
+	assert.Equal(t, 4, rows)
+	assert.Equal(t, 2, cols)
+	assert.True(t, isOrthonormal(orth))
+
+	complement, err := m.OrthComplement(0)
+	assert.Equal(t, nil, err)
+	rows, cols = complement.Dim()
+	assert.Equal(t, 4, rows)
+	assert.Equal(t, 2, cols)
+	assert.True(t, isOrthonormal(complement))
+
+	orthT, _ := orth.Transpose()
+	cross, err := orthT.MultiplyBy(complement)
+	assert.Equal(t, nil, err)
+	assert.True(t, cross.IsSimilar(numericalgo.Matrix{{0, 0}, {0, 0}}, 1e-12))
+
+	null, err := m.NullSpace(0)
+	assert.Equal(t, nil, err)
+	rows, cols = null.Dim()
+	assert.Equal(t, 3, rows)
+	assert.Equal(t, 1, cols)
+	assert.True(t, isOrthonormal(null))
+
+	product, err := m.MultiplyBy(null)
+	assert.Equal(t, nil, err)
+	assert.True(t, product.IsSimilar(numericalgo.Matrix{{0}, {0}, {0}, {0}}, 1e-12))
+}
+
+func TestMatrixNullSpaceFullRank(t *testing.T) {
+	null, err := numericalgo.Matrix{{1, 2}, {3, 4}, {5, 7}}.NullSpace(0)
+	assert.Equal(t, nil, err)
+	_, cols := null.Dim()
+	assert.Equal(t, 0, cols)
+}
@@ -0,0 +1,113 @@
+package numericalgo
+
+import (
+	"fmt"
+	"math"
+)
+
+// Summation selects the algorithm used to add up the elements of a vector.
+type Summation int
+
+const (
+	// Naive adds the elements one by one. Its rounding error grows linearly with the number of elements.
+	Naive Summation = iota
+	// Kahan carries a running compensation for the low-order bits lost in every addition, which makes the error
+	// practically independent of the number of elements.
+	Kahan
+	// Neumaier is Kahan summation improved to also handle elements larger than the running sum. In dot products it
+	// additionally recovers the rounding error of every multiplication, so the result is as accurate as if it was
+	// computed in twice the working precision.
+	Neumaier
+	// Pairwise recursively adds the two halves of the vector, so the error grows only logarithmically with the number
+	// of elements, at nearly the cost of naive summation.
+	Pairwise
+)
+
+// pairwiseBlock is the length below which pairwise summation falls back to naive summation.
+const pairwiseBlock = 8
+
+// SumWith receives the summation algorithm as a parameter. It returns the sum of all elements in the vector computed
+// with that algorithm.
+func (v Vector) SumWith(s Summation) float64 {
+	switch s {
+	case Kahan:
+		var sum, c float64
+		for _, val := range v {
+			y := val - c
+			t := sum + y
+			c = (t - sum) - y
+			sum = t
+		}
+		return sum
+	case Neumaier:
+		var sum, c float64
+		for _, val := range v {
+			var e float64
+			sum, e = twoSum(sum, val)
+			c += e
+		}
+		return sum + c
+	case Pairwise:
+		return v.pairwiseSum()
+	}
+	return v.Sum()
+}
+
+// DotWith receives another vector and the summation algorithm as parameters. It calculates the dot product between
+// the two vectors, adding up the products with that algorithm, and returns the float result and an error (if there
+// is any).
+func (v Vector) DotWith(v2 Vector, s Summation) (float64, error) {
+	switch s {
+	case Naive:
+		return v.Dot(v2)
+	case Neumaier:
+		if !v.AreDimsEqual(v2) {
+			return 0, fmt.Errorf("Dimensions must match")
+		}
+		return dot2(v, v2), nil
+	}
+
+	products, err := v.Mul(v2)
+	if err != nil {
+		return 0, err
+	}
+	return products.SumWith(s), nil
+}
+
+func (v Vector) pairwiseSum() float64 {
+	if len(v) <= pairwiseBlock {
+		return v.Sum()
+	}
+	m := len(v) / 2
+	return v[:m].pairwiseSum() + v[m:].pairwiseSum()
+}
+
+// dot2 computes the dot product of two vectors of equal length as if in twice the working precision, using the
+// error-free transformations of Ogita, Rump and Oishi.
+func dot2(x, y Vector) float64 {
+	if len(x) == 0 {
+		return 0
+	}
+
+	p, s := twoProd(x[0], y[0])
+	for i := 1; i < len(x); i++ {
+		h, r := twoProd(x[i], y[i])
+		var q float64
+		p, q = twoSum(p, h)
+		s += q + r
+	}
+	return p + s
+}
+
+// twoSum returns a+b and the rounding error of that addition.
+func twoSum(a, b float64) (float64, float64) {
+	s := a + b
+	z := s - a
+	return s, (a - (s - z)) + (b - z)
+}
+
+// twoProd returns a*b and the rounding error of that multiplication.
+func twoProd(a, b float64) (float64, float64) {
+	p := a * b
+	return p, math.FMA(a, b, -p)
+}
@@ -0,0 +1,111 @@
+package numericalgo_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVectorSumWith(t *testing.T) {
+	many := make(numericalgo.Vector, 1000000)
+	for i := range many {
+		many[i] = 0.1
+	}
+
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		expectedResult float64
+		tolerance      float64
+	}{
+		"many small elements": {
+			vector:         many,
+			expectedResult: 100000,
+			tolerance:      1e-10,
+		},
+		"cancellation of large elements": {
+			vector:         numericalgo.Vector{1, 1e100, 1, -1e100},
+			expectedResult: 2,
+			tolerance:      0,
+		},
+	}
+
+	summations := map[string]numericalgo.Summation{
+		"neumaier": numericalgo.Neumaier,
+		"pairwise": numericalgo.Pairwise,
+		"kahan":    numericalgo.Kahan,
+	}
+
+	for name, c := range cases {
+		for sName, s := range summations {
+			if s != numericalgo.Neumaier && c.tolerance == 0 {
+				continue
+			}
+			t.Run(name+" with "+sName, func(t *testing.T) {
+				result := c.vector.SumWith(s)
+				assert.InDelta(t, c.expectedResult, result, c.tolerance)
+			})
+		}
+	}
+}
+
+func TestVectorSumWithNaive(t *testing.T) {
+	many := make(numericalgo.Vector, 1000000)
+	for i := range many {
+		many[i] = 0.1
+	}
+
+	naive := math.Abs(many.SumWith(numericalgo.Naive) - 100000)
+	kahan := math.Abs(many.SumWith(numericalgo.Kahan) - 100000)
+	assert.Equal(t, many.Sum(), many.SumWith(numericalgo.Naive))
+	assert.True(t, kahan < naive/1000)
+}
+
+func TestVectorDotWith(t *testing.T) {
+	cases := map[string]struct {
+		vector1        numericalgo.Vector
+		vector2        numericalgo.Vector
+		summation      numericalgo.Summation
+		expectedResult float64
+		expectedError  error
+	}{
+		"naive dot product": {
+			vector1:        numericalgo.Vector{1, 2, 3},
+			vector2:        numericalgo.Vector{4, 5, 6},
+			summation:      numericalgo.Naive,
+			expectedResult: 32,
+			expectedError:  nil,
+		},
+		"pairwise dot product": {
+			vector1:        numericalgo.Vector{1, 2, 3},
+			vector2:        numericalgo.Vector{4, 5, 6},
+			summation:      numericalgo.Pairwise,
+			expectedResult: 32,
+			expectedError:  nil,
+		},
+		"ill-conditioned dot product": {
+			vector1:        numericalgo.Vector{1e8 + 1, 1e8 - 1, -1e8},
+			vector2:        numericalgo.Vector{1e8 - 1, 1e8 + 1, 2e8},
+			summation:      numericalgo.Neumaier,
+			expectedResult: -2,
+			expectedError:  nil,
+		},
+		"wrong dimensions": {
+			vector1:        numericalgo.Vector{1, 2},
+			vector2:        numericalgo.Vector{1, 2, 3},
+			summation:      numericalgo.Neumaier,
+			expectedResult: 0,
+			expectedError:  fmt.Errorf("Dimensions must match"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.vector1.DotWith(c.vector2, c.summation)
+			assert.Equal(t, c.expectedResult, result)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
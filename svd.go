@@ -0,0 +1,222 @@
+package numericalgo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// SVD holds the economy-size singular value decomposition A = U*S*V' of a matrix with k = min(rows, cols) singular
+// values. U and V have k orthonormal columns and the singular values are sorted in non-increasing order.
+type SVD struct {
+	u Matrix
+	s Vector
+	v Matrix
+}
+
+// SVD returns the singular value decomposition of the matrix computed with the one-sided Jacobi method, and the
+// error (if there is any). The Jacobi method orthogonalizes the columns of the matrix with plane rotations until they
+// are orthogonal to working precision, which determines even the small singular values to high relative accuracy.
+func (m Matrix) SVD() (*SVD, error) {
+	if m.isNil() {
+		return nil, fmt.Errorf("Matrix cannot be nil")
+	}
+
+	rows, cols := m.Dim()
+	if rows < cols {
+		mT, err := m.Transpose()
+		if err != nil {
+			return nil, err
+		}
+		f, err := mT.SVD()
+		if err != nil {
+			return nil, err
+		}
+		return &SVD{u: f.v, s: f.s, v: f.u}, nil
+	}
+
+	u := m.copy()
+	v := make(Matrix, cols)
+	for i := range v {
+		v[i] = make(Vector, cols)
+		v[i][i] = 1
+	}
+
+	var normF float64
+	for i := range u {
+		for _, x := range u[i] {
+			normF = math.Hypot(normF, x)
+		}
+	}
+
+	// Columns with norms below tiny consist of rounding errors only. The relative convergence test cannot be applied
+	// to them, as alpha*beta underflows, so they are treated as converged and their singular values are set to zero.
+	eps := machineEpsilon(false)
+	tiny := normF * math.Sqrt(0x1p-1022/eps)
+	const maxSweeps = 100
+	for sweep := 0; ; sweep++ {
+		if sweep == maxSweeps {
+			return nil, fmt.Errorf("Singular value decomposition did not converge")
+		}
+
+		rotated := false
+		for p := 0; p < cols-1; p++ {
+			for q := p + 1; q < cols; q++ {
+				var alpha, beta, gamma float64
+				for i := 0; i < rows; i++ {
+					alpha += u[i][p] * u[i][p]
+					beta += u[i][q] * u[i][q]
+					gamma += u[i][p] * u[i][q]
+				}
+
+				if gamma == 0 || alpha <= tiny*tiny || beta <= tiny*tiny || math.Abs(gamma) <= eps*math.Sqrt(alpha*beta) {
+					continue
+				}
+				rotated = true
+
+				// The rotation which makes columns p and q orthogonal.
+				zeta := (beta - alpha) / (2 * gamma)
+				t := 1 / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(1+t*t)
+				s := c * t
+
+				for i := 0; i < rows; i++ {
+					up, uq := u[i][p], u[i][q]
+					u[i][p], u[i][q] = c*up-s*uq, s*up+c*uq
+				}
+				for i := 0; i < cols; i++ {
+					vp, vq := v[i][p], v[i][q]
+					v[i][p], v[i][q] = c*vp-s*vq, s*vp+c*vq
+				}
+			}
+		}
+
+		if !rotated {
+			break
+		}
+	}
+
+	sv := make(Vector, cols)
+	for j := range sv {
+		var nrm float64
+		for i := 0; i < rows; i++ {
+			nrm = math.Hypot(nrm, u[i][j])
+		}
+		if nrm > tiny {
+			sv[j] = nrm
+		}
+	}
+
+	order := make([]int, cols)
+	for j := range order {
+		order[j] = j
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return sv[order[a]] > sv[order[b]]
+	})
+
+	f := &SVD{u: make(Matrix, rows), s: make(Vector, cols), v: make(Matrix, cols)}
+	for i := range f.u {
+		f.u[i] = make(Vector, cols)
+	}
+	for i := range f.v {
+		f.v[i] = make(Vector, cols)
+	}
+
+	// Columns of U belonging to zero singular values are completed to an orthonormal set.
+	var basis []Vector
+	var zero []int
+	for k, j := range order {
+		f.s[k] = sv[j]
+		for i := 0; i < cols; i++ {
+			f.v[i][k] = v[i][j]
+		}
+
+		if sv[j] == 0 {
+			zero = append(zero, k)
+			continue
+		}
+
+		col := make(Vector, rows)
+		for i := 0; i < rows; i++ {
+			col[i] = u[i][j] / sv[j]
+		}
+		basis = append(basis, col)
+		for i := 0; i < rows; i++ {
+			f.u[i][k] = col[i]
+		}
+	}
+
+	if len(zero) > 0 {
+		candidates := append([]Vector{}, basis...)
+		for i := 0; i < rows; i++ {
+			e := make(Vector, rows)
+			e[i] = 1
+			candidates = append(candidates, e)
+		}
+
+		completed, err := GramSchmidt(candidates, 1e-8)
+		if err != nil {
+			return nil, err
+		}
+
+		for n, k := range zero {
+			for i := 0; i < rows; i++ {
+				f.u[i][k] = completed[len(basis)+n][i]
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// U returns the matrix of the left singular vectors.
+func (f *SVD) U() Matrix {
+	return f.u.copy()
+}
+
+// S returns the singular values in non-increasing order.
+func (f *SVD) S() Vector {
+	s := make(Vector, len(f.s))
+	copy(s, f.s)
+	return s
+}
+
+// V returns the matrix of the right singular vectors.
+func (f *SVD) V() Matrix {
+	return f.v.copy()
+}
+
+// Rank receives the tolerance as a parameter and returns the number of singular values larger than the tolerance.
+// If the tolerance is not positive, the default max(rows, cols) * eps * max(S) is used.
+func (f *SVD) Rank(tol float64) int {
+	if tol <= 0 {
+		n := len(f.u)
+		if len(f.v) > n {
+			n = len(f.v)
+		}
+		if len(f.s) > 0 {
+			tol = float64(n) * machineEpsilon(false) * f.s[0]
+		}
+	}
+
+	var r int
+	for _, s := range f.s {
+		if s > tol {
+			r++
+		}
+	}
+	return r
+}
+
+// Cond returns the 2-norm condition number of the matrix, the ratio of the largest to the smallest singular value.
+// It is infinite for rank-deficient matrices.
+func (f *SVD) Cond() float64 {
+	if len(f.s) == 0 {
+		return 0
+	}
+	return f.s[0] / f.s[len(f.s)-1]
+}
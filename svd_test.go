@@ -0,0 +1,149 @@
+package numericalgo_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSVD(t *testing.T) {
+	cases := map[string]struct {
+		matrix    numericalgo.Matrix
+		singular  numericalgo.Vector
+		rank      int
+		condition float64
+	}{
+		"square matrix": {
+			matrix: numericalgo.Matrix{
+				{3, 0},
+				{4, 5},
+			},
+			singular:  numericalgo.Vector{3 * math.Sqrt(5), math.Sqrt(5)},
+			rank:      2,
+			condition: 3,
+		},
+		"tall matrix": {
+			matrix: numericalgo.Matrix{
+				{1, 0},
+				{0, 2},
+				{0, 0},
+			},
+			singular:  numericalgo.Vector{2, 1},
+			rank:      2,
+			condition: 2,
+		},
+		"wide matrix": {
+			matrix: numericalgo.Matrix{
+				{2, 0, 0},
+				{0, 0, 3},
+			},
+			singular:  numericalgo.Vector{3, 2},
+			rank:      2,
+			condition: 1.5,
+		},
+		"rank deficient matrix": {
+			matrix: numericalgo.Matrix{
+				{1, 2},
+				{2, 4},
+				{3, 6},
+			},
+			singular:  numericalgo.Vector{math.Sqrt(70), 0},
+			rank:      1,
+			condition: math.Inf(1),
+		},
+		"rank deficient square matrix": {
+			matrix: numericalgo.Matrix{
+				{1, 2, 3},
+				{2, 4, 6},
+				{1, 0, 1},
+			},
+			singular:  numericalgo.Vector{math.Sqrt(36 + math.Sqrt(1236)), math.Sqrt(36 - math.Sqrt(1236)), 0},
+			rank:      2,
+			condition: math.Inf(1),
+		},
+		"rank deficient matrix with zero row": {
+			matrix: numericalgo.Matrix{
+				{1, 2, 3},
+				{2, 4, 6},
+				{1, 0, 1},
+				{0, 0, 0},
+			},
+			singular:  numericalgo.Vector{math.Sqrt(36 + math.Sqrt(1236)), math.Sqrt(36 - math.Sqrt(1236)), 0},
+			rank:      2,
+			condition: math.Inf(1),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			svd, err := c.matrix.SVD()
+			assert.Equal(t, nil, err)
+			assert.True(t, svd.S().IsSimilar(c.singular, 1e-14))
+			assert.Equal(t, c.rank, svd.Rank(0))
+			if math.IsInf(c.condition, 1) {
+				assert.True(t, svd.Cond() > 1e15)
+			} else {
+				assert.InEpsilon(t, c.condition, svd.Cond(), 1e-14)
+			}
+
+			u, v := svd.U(), svd.V()
+			assertOrthonormalColumns(t, u)
+			assertOrthonormalColumns(t, v)
+
+			// U*S*V' reconstructs the matrix.
+			s := svd.S()
+			for i := range c.matrix {
+				for j := range c.matrix[i] {
+					var r float64
+					for k := range s {
+						r += u[i][k] * s[k] * v[j][k]
+					}
+					assert.InDelta(t, c.matrix[i][j], r, 1e-14)
+				}
+			}
+		})
+	}
+}
+
+func TestSVDIllConditioned(t *testing.T) {
+	// The graded matrix has singular values spanning 24 orders of magnitude, which the Jacobi method resolves to
+	// high relative accuracy.
+	m := numericalgo.Matrix{
+		{1, 0, 0},
+		{0, 1e-12, 0},
+		{1e-12, 0, 1e-24},
+	}
+
+	svd, err := m.SVD()
+	assert.Equal(t, nil, err)
+	s := svd.S()
+	assert.InEpsilon(t, 1, s[0], 1e-14)
+	assert.InEpsilon(t, 1e-12, s[1], 1e-12)
+	assert.InEpsilon(t, 1e-24, s[2], 1e-10)
+}
+
+func TestSVDNil(t *testing.T) {
+	var m numericalgo.Matrix
+	_, err := m.SVD()
+	assert.Equal(t, fmt.Errorf("Matrix cannot be nil"), err)
+}
+
+func assertOrthonormalColumns(t *testing.T, m numericalgo.Matrix) {
+	_, cols := m.Dim()
+	for p := 0; p < cols; p++ {
+		for q := p; q < cols; q++ {
+			var d float64
+			for i := range m {
+				d += m[i][p] * m[i][q]
+			}
+			if p == q {
+				assert.InDelta(t, 1, d, 1e-14)
+			} else {
+				assert.InDelta(t, 0, d, 1e-14)
+			}
+		}
+	}
+}
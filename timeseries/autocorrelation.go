@@ -0,0 +1,71 @@
+package timeseries
+
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/stats"
+)
+
+// ACF receives the series and the maximum lag. It returns the sample autocorrelations for the lags 0 to maxLag, and
+// the error (if there is any). The autocovariances are divided by n rather than n - k, which keeps the sequence
+// positive semi-definite.
+func ACF(v numericalgo.Vector, maxLag int) (numericalgo.Vector, error) {
+	n := v.Dim()
+	if maxLag < 0 || maxLag >= n {
+		return nil, fmt.Errorf("Maximum lag must be between 0 and the length of the series minus one")
+	}
+
+	mean, err := stats.Mean(v)
+	if err != nil {
+		return nil, err
+	}
+
+	c := make(numericalgo.Vector, maxLag+1)
+	for k := range c {
+		for i := 0; i+k < n; i++ {
+			c[k] += (v[i] - mean) * (v[i+k] - mean)
+		}
+	}
+
+	if c[0] == 0 {
+		return nil, fmt.Errorf("Series cannot be constant")
+	}
+
+	r := make(numericalgo.Vector, maxLag+1)
+	for k := range r {
+		r[k] = c[k] / c[0]
+	}
+	return r, nil
+}
+
+// PACF receives the series and the maximum lag. It returns the sample partial autocorrelations for the lags 0 to
+// maxLag, computed from the autocorrelations with the Durbin-Levinson recursion, and the error (if there is any).
+// The partial autocorrelation at lag k is the last coefficient of the best linear predictor of order k, and the
+// element for lag 0 is 1 by convention.
+func PACF(v numericalgo.Vector, maxLag int) (numericalgo.Vector, error) {
+	rho, err := ACF(v, maxLag)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(numericalgo.Vector, maxLag+1)
+	r[0] = 1
+
+	phi := make(numericalgo.Vector, maxLag+1)
+	prev := make(numericalgo.Vector, maxLag+1)
+	for k := 1; k <= maxLag; k++ {
+		num, den := rho[k], 1.0
+		for j := 1; j < k; j++ {
+			num -= prev[j] * rho[k-j]
+			den -= prev[j] * rho[j]
+		}
+		phi[k] = num / den
+		for j := 1; j < k; j++ {
+			phi[j] = prev[j] - phi[k]*prev[k-j]
+		}
+		r[k] = phi[k]
+		copy(prev, phi)
+	}
+	return r, nil
+}
@@ -0,0 +1,31 @@
+package timeseries_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/timeseries"
+	"github.com/stretchr/testify/assert"
+)
+
+var series = numericalgo.Vector{1.2, 0.8, 1.9, 2.4, 1.1, 0.3, 0.9, 1.7, 2.8, 2.2, 1.0, 0.4}
+
+func TestACF(t *testing.T) {
+	result, err := timeseries.ACF(series, 3)
+	assert.Nil(t, err)
+	assert.True(t, result.IsSimilar(numericalgo.Vector{1, 0.3375004925720141, -0.5578870630886236, -0.6544804350396027}, 1e-14))
+
+	_, err = timeseries.ACF(series, 12)
+	assert.Equal(t, fmt.Errorf("Maximum lag must be between 0 and the length of the series minus one"), err)
+
+	_, err = timeseries.ACF(numericalgo.Vector{2, 2, 2}, 1)
+	assert.Equal(t, fmt.Errorf("Series cannot be constant"), err)
+}
+
+func TestPACF(t *testing.T) {
+	// The partial autocorrelations are the last coefficients of the Yule-Walker solutions of increasing order.
+	result, err := timeseries.PACF(series, 3)
+	assert.Nil(t, err)
+	assert.True(t, result.IsSimilar(numericalgo.Vector{1, 0.3375004925720141, -0.7581521680411631, -0.17932940603311695}, 1e-14))
+}
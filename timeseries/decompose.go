@@ -0,0 +1,94 @@
+package timeseries
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Decomposition holds the trend, seasonal and residual components of a series. The trend and residual are NaN for
+// the first and last period/2 elements, where the centered moving average is not defined.
+type Decomposition struct {
+	Trend    numericalgo.Vector
+	Seasonal numericalgo.Vector
+	Residual numericalgo.Vector
+}
+
+// Decompose receives the series, the seasonal period and the kind of seasonality. It performs the classical
+// seasonal decomposition and returns the components, and the error (if there is any). The trend is the centered
+// moving average over one period (a 2 x period average for even periods), the seasonal component is the average of
+// the detrended series at every position of the period, normalized to sum to zero (additive) or to average one
+// (multiplicative), and the residual is what remains.
+func Decompose(v numericalgo.Vector, period int, seasonality Seasonality) (*Decomposition, error) {
+	n := v.Dim()
+	if period < 2 {
+		return nil, fmt.Errorf("Period must be at least 2")
+	}
+
+	if n < 2*period {
+		return nil, fmt.Errorf("Series must have at least two full periods")
+	}
+
+	if seasonality != Additive && seasonality != Multiplicative {
+		return nil, fmt.Errorf("Unknown seasonality")
+	}
+
+	weights := make(numericalgo.Vector, period+1-period%2)
+	for i := range weights {
+		weights[i] = 1
+	}
+	if period%2 == 0 {
+		weights[0], weights[period] = 0.5, 0.5
+	}
+
+	ma, err := WMA(v, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	half := len(weights) / 2
+	trend := make(numericalgo.Vector, n)
+	for i := range trend {
+		trend[i] = math.NaN()
+	}
+	copy(trend[half:], ma)
+
+	sums := make(numericalgo.Vector, period)
+	counts := make([]int, period)
+	for i := half; i < n-half; i++ {
+		if seasonality == Multiplicative {
+			if trend[i] == 0 {
+				return nil, fmt.Errorf("Multiplicative seasonality requires a non-zero trend")
+			}
+			sums[i%period] += v[i] / trend[i]
+		} else {
+			sums[i%period] += v[i] - trend[i]
+		}
+		counts[i%period]++
+	}
+
+	var mean float64
+	for i := range sums {
+		sums[i] /= float64(counts[i])
+		mean += sums[i] / float64(period)
+	}
+	for i := range sums {
+		if seasonality == Multiplicative {
+			sums[i] /= mean
+		} else {
+			sums[i] -= mean
+		}
+	}
+
+	d := &Decomposition{Trend: trend, Seasonal: make(numericalgo.Vector, n), Residual: make(numericalgo.Vector, n)}
+	for i := range v {
+		d.Seasonal[i] = sums[i%period]
+		if seasonality == Multiplicative {
+			d.Residual[i] = v[i] / (trend[i] * d.Seasonal[i])
+		} else {
+			d.Residual[i] = v[i] - trend[i] - d.Seasonal[i]
+		}
+	}
+	return d, nil
+}
@@ -0,0 +1,85 @@
+package timeseries_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/timeseries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecompose(t *testing.T) {
+	cases := map[string]struct {
+		period      int
+		seasonality timeseries.Seasonality
+		pattern     numericalgo.Vector
+	}{
+		"additive even period": {
+			period:      4,
+			seasonality: timeseries.Additive,
+			pattern:     numericalgo.Vector{3, -1, -4, 2},
+		},
+		"additive odd period": {
+			period:      3,
+			seasonality: timeseries.Additive,
+			pattern:     numericalgo.Vector{2, -3, 1},
+		},
+		"multiplicative": {
+			period:      4,
+			seasonality: timeseries.Multiplicative,
+			pattern:     numericalgo.Vector{1.2, 0.9, 0.7, 1.2},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			// A linear trend combined with an exactly periodic pattern is recovered without residual.
+			n := 5 * c.period
+			v := make(numericalgo.Vector, n)
+			for i := range v {
+				trend := 50 + 0.5*float64(i)
+				if c.seasonality == timeseries.Multiplicative {
+					v[i] = trend * c.pattern[i%c.period]
+				} else {
+					v[i] = trend + c.pattern[i%c.period]
+				}
+			}
+
+			d, err := timeseries.Decompose(v, c.period, c.seasonality)
+			assert.Nil(t, err)
+
+			half := c.period / 2
+			assert.True(t, math.IsNaN(d.Trend[half-1]))
+			assert.True(t, math.IsNaN(d.Trend[n-half]))
+
+			if c.seasonality == timeseries.Additive {
+				for i := half; i < n-half; i++ {
+					assert.InDelta(t, 50+0.5*float64(i), d.Trend[i], 1e-12)
+					assert.InDelta(t, 0, d.Residual[i], 1e-12)
+				}
+				for i := range v {
+					assert.InDelta(t, c.pattern[i%c.period], d.Seasonal[i], 1e-12)
+				}
+			} else {
+				// The moving average of a multiplicative series is only approximately the trend, but the
+				// seasonal factors still average to one.
+				var mean float64
+				for i := 0; i < c.period; i++ {
+					mean += d.Seasonal[i] / float64(c.period)
+					assert.InEpsilon(t, c.pattern[i], d.Seasonal[i], 0.02)
+				}
+				assert.InEpsilon(t, 1, mean, 1e-14)
+			}
+		})
+	}
+}
+
+func TestDecomposeInvalid(t *testing.T) {
+	_, err := timeseries.Decompose(numericalgo.Vector{1, 2, 3}, 2, timeseries.Additive)
+	assert.Equal(t, fmt.Errorf("Series must have at least two full periods"), err)
+
+	_, err = timeseries.Decompose(numericalgo.Vector{1, 2, 3}, 1, timeseries.Additive)
+	assert.Equal(t, fmt.Errorf("Period must be at least 2"), err)
+}
@@ -0,0 +1,46 @@
+package timeseries
+
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Diff receives the series, the lag and the order. It applies the lagged difference d_i = v_(i+lag) - v_i order
+// times and returns the result, which has n - order*lag elements, and the error (if there is any). A lag equal to the
+// seasonal period removes the seasonal component, and the order 1 or 2 with lag 1 removes a linear or quadratic
+// trend.
+func Diff(v numericalgo.Vector, lag, order int) (numericalgo.Vector, error) {
+	if lag < 1 || order < 0 {
+		return nil, fmt.Errorf("Lag must be positive and order cannot be negative")
+	}
+
+	if order*lag >= v.Dim() {
+		return nil, fmt.Errorf("Series is too short for the given lag and order")
+	}
+
+	r := make(numericalgo.Vector, v.Dim())
+	copy(r, v)
+	for k := 0; k < order; k++ {
+		for i := 0; i+lag < len(r); i++ {
+			r[i] = r[i+lag] - r[i]
+		}
+		r = r[:len(r)-lag]
+	}
+	return r, nil
+}
+
+// Undiff receives the differenced series, the lag and the initial lag elements of the original series. It inverts
+// one lagged difference and returns the original series, and the error (if there is any).
+func Undiff(d numericalgo.Vector, lag int, initial numericalgo.Vector) (numericalgo.Vector, error) {
+	if lag < 1 || initial.Dim() != lag {
+		return nil, fmt.Errorf("Number of initial values must equal the lag")
+	}
+
+	r := make(numericalgo.Vector, d.Dim()+lag)
+	copy(r, initial)
+	for i, val := range d {
+		r[i+lag] = r[i] + val
+	}
+	return r, nil
+}
@@ -0,0 +1,69 @@
+package timeseries_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/timeseries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	cases := map[string]struct {
+		v              numericalgo.Vector
+		lag            int
+		order          int
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"first difference": {
+			v:              numericalgo.Vector{1, 4, 9, 16, 25},
+			lag:            1,
+			order:          1,
+			expectedResult: numericalgo.Vector{3, 5, 7, 9},
+			expectedError:  nil,
+		},
+		"second difference removes the quadratic trend": {
+			v:              numericalgo.Vector{1, 4, 9, 16, 25},
+			lag:            1,
+			order:          2,
+			expectedResult: numericalgo.Vector{2, 2, 2},
+			expectedError:  nil,
+		},
+		"seasonal difference": {
+			v:              numericalgo.Vector{1, 5, 2, 2, 6, 3, 3, 7},
+			lag:            3,
+			order:          1,
+			expectedResult: numericalgo.Vector{1, 1, 1, 1, 1},
+			expectedError:  nil,
+		},
+		"series too short": {
+			v:              numericalgo.Vector{1, 2, 3},
+			lag:            2,
+			order:          2,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Series is too short for the given lag and order"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := timeseries.Diff(c.v, c.lag, c.order)
+			assert.Equal(t, c.expectedResult, result)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestUndiff(t *testing.T) {
+	v := numericalgo.Vector{1, 5, 2, 2, 6, 3, 3, 7}
+	d, _ := timeseries.Diff(v, 3, 1)
+
+	result, err := timeseries.Undiff(d, 3, v[:3])
+	assert.Nil(t, err)
+	assert.Equal(t, v, result)
+
+	_, err = timeseries.Undiff(d, 3, v[:2])
+	assert.Equal(t, fmt.Errorf("Number of initial values must equal the lag"), err)
+}
@@ -0,0 +1,169 @@
+package timeseries
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// Seasonality is the way the seasonal component combines with the level and trend of a series.
+type Seasonality int
+
+const (
+	// Additive seasonality adds a seasonal component of constant amplitude.
+	Additive Seasonality = iota
+	// Multiplicative seasonality multiplies by a seasonal factor, so the amplitude grows with the level.
+	Multiplicative
+)
+
+// HoltWinters is triple exponential smoothing: the level, the trend and the seasonal component of the series are
+// smoothed with the factors Alpha, Beta and Gamma. With Period 0 it reduces to Holt's linear trend method.
+//
+// After fitting, Fitted holds the one-step-ahead predictions of the series, NaN for the elements used for
+// initialization, and SSE holds the sum of squared one-step-ahead errors.
+type HoltWinters struct {
+	Alpha       float64
+	Beta        float64
+	Gamma       float64
+	Period      int
+	Seasonality Seasonality
+	Fitted      numericalgo.Vector
+	SSE         float64
+	level       float64
+	trend       float64
+	season      numericalgo.Vector
+	fitted      bool
+}
+
+// NewHoltWinters receives the smoothing factors of the level, trend and season, the seasonal period (0 for no
+// seasonality) and the kind of seasonality, and returns the pointer to the new HoltWinters type.
+func NewHoltWinters(alpha, beta, gamma float64, period int, seasonality Seasonality) *HoltWinters {
+	hw := &HoltWinters{Alpha: alpha, Beta: beta, Gamma: gamma, Period: period, Seasonality: seasonality}
+	return hw
+}
+
+// Fit receives the series and smooths it, storing the final level, trend and seasonal components for forecasting.
+// It returns the error if something went wrong. The level and trend are initialized from the means of the first two
+// seasons, and the seasonal components from the first season, so the series needs at least two full periods.
+func (hw *HoltWinters) Fit(v numericalgo.Vector) error {
+	if !(hw.Alpha > 0 && hw.Alpha <= 1) || !(hw.Beta >= 0 && hw.Beta <= 1) || !(hw.Gamma >= 0 && hw.Gamma <= 1) {
+		return fmt.Errorf("Smoothing factors must be in [0, 1] and alpha must be positive")
+	}
+
+	m := hw.Period
+	if m < 0 {
+		return fmt.Errorf("Period cannot be negative")
+	}
+
+	if m > 0 && hw.Seasonality != Additive && hw.Seasonality != Multiplicative {
+		return fmt.Errorf("Unknown seasonality")
+	}
+
+	n := v.Dim()
+	if n < 2 || m > 0 && n < 2*m {
+		return fmt.Errorf("Series must have at least two elements and two full periods")
+	}
+
+	multiplicative := m > 0 && hw.Seasonality == Multiplicative
+	if multiplicative {
+		for _, val := range v {
+			if val <= 0 {
+				return fmt.Errorf("Multiplicative seasonality requires a positive series")
+			}
+		}
+	}
+
+	var start int
+	var season numericalgo.Vector
+	if m == 0 {
+		hw.level, hw.trend = v[0], v[1]-v[0]
+		start = 1
+	} else {
+		first := v[:m].SumWith(numericalgo.Neumaier) / float64(m)
+		second := v[m:2*m].SumWith(numericalgo.Neumaier) / float64(m)
+		hw.level, hw.trend = first, (second-first)/float64(m)
+
+		season = make(numericalgo.Vector, m)
+		for i := range season {
+			if multiplicative {
+				season[i] = v[i] / first
+			} else {
+				season[i] = v[i] - first
+			}
+		}
+		start = m
+	}
+
+	hw.Fitted = make(numericalgo.Vector, n)
+	for i := 0; i < start; i++ {
+		hw.Fitted[i] = math.NaN()
+	}
+	hw.SSE = 0
+
+	for t := start; t < n; t++ {
+		var s float64
+		if m > 0 {
+			s = season[t%m]
+		}
+
+		prediction := hw.level + hw.trend
+		switch {
+		case multiplicative:
+			prediction *= s
+		case m > 0:
+			prediction += s
+		}
+		hw.Fitted[t] = prediction
+		hw.SSE += (v[t] - prediction) * (v[t] - prediction)
+
+		prevLevel := hw.level
+		switch {
+		case multiplicative:
+			hw.level = hw.Alpha*v[t]/s + (1-hw.Alpha)*(prevLevel+hw.trend)
+		default:
+			hw.level = hw.Alpha*(v[t]-s) + (1-hw.Alpha)*(prevLevel+hw.trend)
+		}
+		hw.trend = hw.Beta*(hw.level-prevLevel) + (1-hw.Beta)*hw.trend
+
+		switch {
+		case multiplicative:
+			season[t%m] = hw.Gamma*v[t]/hw.level + (1-hw.Gamma)*s
+		case m > 0:
+			season[t%m] = hw.Gamma*(v[t]-hw.level) + (1-hw.Gamma)*s
+		}
+	}
+
+	// The seasonal components are stored in the order of the season following the end of the series.
+	hw.season = make(numericalgo.Vector, m)
+	for i := range hw.season {
+		hw.season[i] = season[(n+i)%m]
+	}
+	hw.fitted = true
+	return nil
+}
+
+// Forecast receives the horizon and returns the forecasts of the next h elements of the series, and the error (if
+// there is any).
+func (hw *HoltWinters) Forecast(h int) (numericalgo.Vector, error) {
+	if !hw.fitted {
+		return nil, fmt.Errorf("Model has not been fitted")
+	}
+
+	if h < 0 {
+		return nil, fmt.Errorf("Horizon cannot be negative")
+	}
+
+	r := make(numericalgo.Vector, h)
+	for i := range r {
+		r[i] = hw.level + float64(i+1)*hw.trend
+		if m := len(hw.season); m > 0 {
+			if hw.Seasonality == Multiplicative {
+				r[i] *= hw.season[i%m]
+			} else {
+				r[i] += hw.season[i%m]
+			}
+		}
+	}
+	return r, nil
+}
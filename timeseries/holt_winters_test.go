@@ -0,0 +1,79 @@
+package timeseries_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/timeseries"
+	"github.com/stretchr/testify/assert"
+)
+
+var airline = numericalgo.Vector{
+	112, 118, 132, 129, 121, 135, 148, 148, 136, 119, 104, 118,
+	115, 126, 141, 135, 125, 149, 170, 170, 158, 133, 114, 140,
+}
+
+func TestHoltWinters(t *testing.T) {
+	cases := map[string]struct {
+		seasonality timeseries.Seasonality
+		sse         float64
+		fitted      numericalgo.Vector
+		forecast    numericalgo.Vector
+	}{
+		"additive": {
+			seasonality: timeseries.Additive,
+			sse:         455.9150079758988,
+			fitted:      numericalgo.Vector{113.08333333333333, 120.79916666666664},
+			forecast:    numericalgo.Vector{133.821566014804, 141.66451099101513, 156.78767746722625},
+		},
+		"multiplicative": {
+			seasonality: timeseries.Multiplicative,
+			sse:         280.0982308622511,
+			fitted:      numericalgo.Vector{112.9578947368421, 120.7284172932331},
+			forecast:    numericalgo.Vector{131.7966892031966, 140.71910564824574, 158.44468395284335},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			hw := timeseries.NewHoltWinters(0.3, 0.1, 0.2, 12, c.seasonality)
+			err := hw.Fit(airline)
+			assert.Nil(t, err)
+
+			assert.InEpsilon(t, c.sse, hw.SSE, 1e-12)
+			assert.True(t, math.IsNaN(hw.Fitted[11]))
+			assert.True(t, hw.Fitted[12:14].IsSimilar(c.fitted, 1e-12))
+
+			forecast, err := hw.Forecast(3)
+			assert.Nil(t, err)
+			assert.True(t, forecast.IsSimilar(c.forecast, 1e-11))
+		})
+	}
+}
+
+func TestHoltLinearTrend(t *testing.T) {
+	// Without seasonality a linear series is predicted exactly.
+	hw := timeseries.NewHoltWinters(0.5, 0.5, 0, 0, timeseries.Additive)
+	assert.Nil(t, hw.Fit(numericalgo.Vector{3, 5, 7, 9, 11}))
+	assert.Equal(t, 0.0, hw.SSE)
+
+	forecast, err := hw.Forecast(2)
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Vector{13, 15}, forecast)
+}
+
+func TestHoltWintersInvalid(t *testing.T) {
+	_, err := timeseries.NewHoltWinters(0.5, 0.1, 0.1, 4, timeseries.Additive).Forecast(2)
+	assert.Equal(t, fmt.Errorf("Model has not been fitted"), err)
+
+	err = timeseries.NewHoltWinters(0, 0.1, 0.1, 4, timeseries.Additive).Fit(airline)
+	assert.Equal(t, fmt.Errorf("Smoothing factors must be in [0, 1] and alpha must be positive"), err)
+
+	err = timeseries.NewHoltWinters(0.5, 0.1, 0.1, 13, timeseries.Additive).Fit(airline)
+	assert.Equal(t, fmt.Errorf("Series must have at least two elements and two full periods"), err)
+
+	err = timeseries.NewHoltWinters(0.5, 0.1, 0.1, 2, timeseries.Multiplicative).Fit(numericalgo.Vector{1, -1, 2, 3})
+	assert.Equal(t, fmt.Errorf("Multiplicative seasonality requires a positive series"), err)
+}
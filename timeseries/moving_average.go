@@ -0,0 +1,76 @@
+package timeseries
+
+import (
+	"fmt"
+
+	"github.com/DzananGanic/numericalgo"
+)
+
+// SMA receives the series and the window length. It returns the simple moving average, whose i-th element is the
+// mean of the elements i to i+window-1, and the error (if there is any). The result has n - window + 1 elements.
+func SMA(v numericalgo.Vector, window int) (numericalgo.Vector, error) {
+	if window < 1 || window > v.Dim() {
+		return nil, fmt.Errorf("Window must be between 1 and the length of the series")
+	}
+
+	r := make(numericalgo.Vector, v.Dim()-window+1)
+	for i := range r {
+		r[i] = v[i:i+window].SumWith(numericalgo.Neumaier) / float64(window)
+	}
+	return r, nil
+}
+
+// WMA receives the series and the weights of the window, the last weight belonging to the most recent element. It
+// returns the weighted moving average sum(w_j * v_(i+j)) / sum(w_j), and the error (if there is any). The result has
+// n - len(weights) + 1 elements. Use LinearWeights for the common linearly weighted moving average.
+func WMA(v, weights numericalgo.Vector) (numericalgo.Vector, error) {
+	window := weights.Dim()
+	if window < 1 || window > v.Dim() {
+		return nil, fmt.Errorf("Window must be between 1 and the length of the series")
+	}
+
+	total := weights.SumWith(numericalgo.Neumaier)
+	if total == 0 {
+		return nil, fmt.Errorf("Weights cannot sum to zero")
+	}
+
+	r := make(numericalgo.Vector, v.Dim()-window+1)
+	for i := range r {
+		s, err := v[i:i+window].DotWith(weights, numericalgo.Neumaier)
+		if err != nil {
+			return nil, err
+		}
+		r[i] = s / total
+	}
+	return r, nil
+}
+
+// LinearWeights receives the window length and returns the weights 1, 2, ..., window of the linearly weighted
+// moving average.
+func LinearWeights(window int) numericalgo.Vector {
+	w := make(numericalgo.Vector, window)
+	for i := range w {
+		w[i] = float64(i + 1)
+	}
+	return w
+}
+
+// EMA receives the series and the smoothing factor alpha in (0, 1]. It returns the exponential moving average
+// s_0 = v_0, s_i = alpha*v_i + (1 - alpha)*s_(i-1), which has the same length as the series, and the error (if
+// there is any).
+func EMA(v numericalgo.Vector, alpha float64) (numericalgo.Vector, error) {
+	if !(alpha > 0 && alpha <= 1) {
+		return nil, fmt.Errorf("Smoothing factor must be in (0, 1]")
+	}
+
+	if v.Dim() == 0 {
+		return nil, fmt.Errorf("Vector cannot be empty")
+	}
+
+	r := make(numericalgo.Vector, v.Dim())
+	r[0] = v[0]
+	for i := 1; i < len(v); i++ {
+		r[i] = alpha*v[i] + (1-alpha)*r[i-1]
+	}
+	return r, nil
+}
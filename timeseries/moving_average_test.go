@@ -0,0 +1,69 @@
+package timeseries_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DzananGanic/numericalgo"
+	"github.com/DzananGanic/numericalgo/timeseries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSMA(t *testing.T) {
+	cases := map[string]struct {
+		v              numericalgo.Vector
+		window         int
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"window of three": {
+			v:              numericalgo.Vector{1, 2, 3, 4, 5, 6},
+			window:         3,
+			expectedResult: numericalgo.Vector{2, 3, 4, 5},
+			expectedError:  nil,
+		},
+		"window of one": {
+			v:              numericalgo.Vector{1, 5, 2},
+			window:         1,
+			expectedResult: numericalgo.Vector{1, 5, 2},
+			expectedError:  nil,
+		},
+		"window too long": {
+			v:              numericalgo.Vector{1, 5, 2},
+			window:         4,
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Window must be between 1 and the length of the series"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := timeseries.SMA(c.v, c.window)
+			assert.Equal(t, c.expectedResult, result)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestWMA(t *testing.T) {
+	v := numericalgo.Vector{1, 2, 3, 4, 5}
+
+	result, err := timeseries.WMA(v, timeseries.LinearWeights(3))
+	assert.Nil(t, err)
+	assert.True(t, result.IsSimilar(numericalgo.Vector{14.0 / 6, 20.0 / 6, 26.0 / 6}, 1e-15))
+
+	_, err = timeseries.WMA(v, numericalgo.Vector{1, -1})
+	assert.Equal(t, fmt.Errorf("Weights cannot sum to zero"), err)
+}
+
+func TestEMA(t *testing.T) {
+	result, err := timeseries.EMA(numericalgo.Vector{2, 4, 8, 4}, 0.5)
+	assert.Nil(t, err)
+	assert.Equal(t, numericalgo.Vector{2, 3, 5.5, 4.75}, result)
+
+	_, err = timeseries.EMA(numericalgo.Vector{2, 4}, 0)
+	assert.Equal(t, fmt.Errorf("Smoothing factor must be in (0, 1]"), err)
+
+	_, err = timeseries.EMA(numericalgo.Vector{}, 0.5)
+	assert.Equal(t, fmt.Errorf("Vector cannot be empty"), err)
+}
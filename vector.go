@@ -3,6 +3,7 @@ package numericalgo
 import (
 	"fmt"
 	"math"
+	"sort"
 )
 
 // Vector is the []float64 which has custom methods needed for vector operations.
@@ -126,3 +127,273 @@ func (v Vector) DivideByScalar(s float64) (Vector, error) {
 
 	return r, nil
 }
+
+// Norm receives the order p as a parameter. It returns the p-norm of the vector (sum(|x_i|^p))^(1/p), and the error
+// (if there is any). The order must be at least 1, and math.Inf(1) gives the maximum norm max|x_i|.
+func (v Vector) Norm(p float64) (float64, error) {
+	switch {
+	case p < 1 || math.IsNaN(p):
+		return 0, fmt.Errorf("Norm order must be at least 1")
+	case math.IsInf(p, 1):
+		return v.maxAbs(), nil
+	case p == 1:
+		var sum float64
+		for _, val := range v {
+			sum += math.Abs(val)
+		}
+		return sum, nil
+	case p == 2:
+		return v.norm2(), nil
+	}
+
+	// Scaling by the largest element prevents overflow and underflow of |x_i|^p.
+	scale := v.maxAbs()
+	if scale == 0 || math.IsInf(scale, 1) {
+		return scale, nil
+	}
+
+	var sum float64
+	for _, val := range v {
+		sum += math.Pow(math.Abs(val)/scale, p)
+	}
+	return scale * math.Pow(sum, 1/p), nil
+}
+
+// Normalize returns the vector scaled to unit Euclidean norm, and the error (if there is any).
+func (v Vector) Normalize() (Vector, error) {
+	n := v.norm2()
+	if n == 0 {
+		return nil, fmt.Errorf("Cannot normalize zero vector")
+	}
+	return v.DivideByScalar(n)
+}
+
+// Cross receives another vector as a parameter. It returns the cross product of the two 3-dimensional vectors and the
+// error (if there is any).
+func (v Vector) Cross(v2 Vector) (Vector, error) {
+	if v.Dim() != 3 || v2.Dim() != 3 {
+		return nil, fmt.Errorf("Cross product is defined only for 3-dimensional vectors")
+	}
+
+	return Vector{
+		v[1]*v2[2] - v[2]*v2[1],
+		v[2]*v2[0] - v[0]*v2[2],
+		v[0]*v2[1] - v[1]*v2[0],
+	}, nil
+}
+
+// Angle receives another vector as a parameter. It returns the angle between the two vectors in radians, and the
+// error (if there is any). The angle is computed as 2*atan2(||u - w||, ||u + w||) for the unit vectors u and w, which
+// stays accurate for nearly parallel vectors, where acos of the normalized dot product loses all precision.
+func (v Vector) Angle(v2 Vector) (float64, error) {
+	if !v.AreDimsEqual(v2) {
+		return 0, fmt.Errorf("Dimensions must match")
+	}
+
+	u, err := v.Normalize()
+	if err != nil {
+		return 0, err
+	}
+
+	w, err := v2.Normalize()
+	if err != nil {
+		return 0, err
+	}
+
+	diff, _ := u.Subtract(w)
+	sum, _ := u.Add(w)
+	return 2 * math.Atan2(diff.norm2(), sum.norm2()), nil
+}
+
+// Project receives another vector as a parameter. It returns the orthogonal projection of the vector onto the
+// provided vector, and the error (if there is any).
+func (v Vector) Project(v2 Vector) (Vector, error) {
+	d, err := v.Dot(v2)
+	if err != nil {
+		return nil, err
+	}
+
+	n, _ := v2.Dot(v2)
+	if n == 0 {
+		return nil, fmt.Errorf("Cannot project onto zero vector")
+	}
+
+	return v2.MultiplyByScalar(d / n), nil
+}
+
+// Mul receives another vector as a parameter. It multiplies the vectors element-wise and returns the result vector
+// and the error (if there is any).
+func (v Vector) Mul(v2 Vector) (Vector, error) {
+	var r Vector
+
+	if !v.AreDimsEqual(v2) {
+		return r, fmt.Errorf("Dimensions must match")
+	}
+
+	for index := range v {
+		r = append(r, v[index]*v2[index])
+	}
+
+	return r, nil
+}
+
+// Div receives another vector as a parameter. It divides the vectors element-wise and returns the result vector and
+// the error (if there is any).
+func (v Vector) Div(v2 Vector) (Vector, error) {
+	var r Vector
+
+	if !v.AreDimsEqual(v2) {
+		return r, fmt.Errorf("Dimensions must match")
+	}
+
+	for index := range v {
+		if v2[index] == 0 {
+			return nil, fmt.Errorf("Cannot divide by zero")
+		}
+		r = append(r, v[index]/v2[index])
+	}
+
+	return r, nil
+}
+
+// Apply receives a function as a parameter. It returns the vector whose elements are f(x).
+func (v Vector) Apply(f func(float64) float64) Vector {
+	var r Vector
+
+	for _, val := range v {
+		r = append(r, f(val))
+	}
+
+	return r
+}
+
+// CumSum returns the vector of cumulative sums, whose i-th element is the sum of the first i+1 elements.
+func (v Vector) CumSum() Vector {
+	var r Vector
+	var sum float64
+
+	for _, val := range v {
+		sum += val
+		r = append(r, sum)
+	}
+
+	return r
+}
+
+// CumProd returns the vector of cumulative products, whose i-th element is the product of the first i+1 elements.
+func (v Vector) CumProd() Vector {
+	var r Vector
+	prod := 1.0
+
+	for _, val := range v {
+		prod *= val
+		r = append(r, prod)
+	}
+
+	return r
+}
+
+// Min returns the smallest element of the vector and the error (if there is any).
+func (v Vector) Min() (float64, error) {
+	i, err := v.ArgMin()
+	if err != nil {
+		return 0, err
+	}
+	return v[i], nil
+}
+
+// Max returns the largest element of the vector and the error (if there is any).
+func (v Vector) Max() (float64, error) {
+	i, err := v.ArgMax()
+	if err != nil {
+		return 0, err
+	}
+	return v[i], nil
+}
+
+// ArgMin returns the index of the first occurrence of the smallest element of the vector, and the error (if there is
+// any).
+func (v Vector) ArgMin() (int, error) {
+	if v.Dim() == 0 {
+		return 0, fmt.Errorf("Vector cannot be empty")
+	}
+
+	r := 0
+	for i, val := range v {
+		if val < v[r] {
+			r = i
+		}
+	}
+	return r, nil
+}
+
+// ArgMax returns the index of the first occurrence of the largest element of the vector, and the error (if there is
+// any).
+func (v Vector) ArgMax() (int, error) {
+	if v.Dim() == 0 {
+		return 0, fmt.Errorf("Vector cannot be empty")
+	}
+
+	r := 0
+	for i, val := range v {
+		if val > v[r] {
+			r = i
+		}
+	}
+	return r, nil
+}
+
+// Argsort returns the indices that would sort the vector in ascending order. Equal elements keep their original
+// order.
+func (v Vector) Argsort() []int {
+	idx := make([]int, len(v))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	sort.SliceStable(idx, func(i, j int) bool {
+		return v[idx[i]] < v[idx[j]]
+	})
+
+	return idx
+}
+
+// Unique returns the sorted vector of distinct elements.
+func (v Vector) Unique() Vector {
+	var r Vector
+
+	for _, i := range v.Argsort() {
+		if len(r) == 0 || v[i] != r[len(r)-1] {
+			r = append(r, v[i])
+		}
+	}
+
+	return r
+}
+
+// Reverse returns the vector with the elements in reverse order.
+func (v Vector) Reverse() Vector {
+	var r Vector
+
+	for i := len(v) - 1; i >= 0; i-- {
+		r = append(r, v[i])
+	}
+
+	return r
+}
+
+func (v Vector) norm2() float64 {
+	var r float64
+	for _, val := range v {
+		r = math.Hypot(r, val)
+	}
+	return r
+}
+
+func (v Vector) maxAbs() float64 {
+	var r float64
+	for _, val := range v {
+		r = math.Max(r, math.Abs(val))
+	}
+	return r
+}
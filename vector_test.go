@@ -2,6 +2,7 @@ package numericalgo_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/DzananGanic/numericalgo"
@@ -264,3 +265,364 @@ func TestVectorIsSimilar(t *testing.T) {
 		})
 	}
 }
+
+func TestVectorNorm(t *testing.T) {
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		p              float64
+		expectedResult float64
+		expectedError  error
+	}{
+		"1-norm": {
+			vector:         numericalgo.Vector{3, -4},
+			p:              1,
+			expectedResult: 7,
+			expectedError:  nil,
+		},
+		"euclidean norm": {
+			vector:         numericalgo.Vector{3, -4},
+			p:              2,
+			expectedResult: 5,
+			expectedError:  nil,
+		},
+		"3-norm": {
+			vector:         numericalgo.Vector{1, 2, 2},
+			p:              3,
+			expectedResult: math.Cbrt(17),
+			expectedError:  nil,
+		},
+		"maximum norm": {
+			vector:         numericalgo.Vector{3, -4},
+			p:              math.Inf(1),
+			expectedResult: 4,
+			expectedError:  nil,
+		},
+		"euclidean norm without overflow": {
+			vector:         numericalgo.Vector{3e200, 4e200},
+			p:              2,
+			expectedResult: 5e200,
+			expectedError:  nil,
+		},
+		"invalid order": {
+			vector:         numericalgo.Vector{3, -4},
+			p:              0.5,
+			expectedResult: 0,
+			expectedError:  fmt.Errorf("Norm order must be at least 1"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.vector.Norm(c.p)
+			assert.InDelta(t, c.expectedResult, result, 1e-12*c.expectedResult)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestVectorNormalize(t *testing.T) {
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"basic normalization": {
+			vector:         numericalgo.Vector{3, 4},
+			expectedResult: numericalgo.Vector{0.6, 0.8},
+			expectedError:  nil,
+		},
+		"zero vector": {
+			vector:         numericalgo.Vector{0, 0},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Cannot normalize zero vector"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.vector.Normalize()
+			assert.True(t, result.IsSimilar(c.expectedResult, 1e-15))
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestVectorCross(t *testing.T) {
+	cases := map[string]struct {
+		vector1        numericalgo.Vector
+		vector2        numericalgo.Vector
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"unit vectors": {
+			vector1:        numericalgo.Vector{1, 0, 0},
+			vector2:        numericalgo.Vector{0, 1, 0},
+			expectedResult: numericalgo.Vector{0, 0, 1},
+			expectedError:  nil,
+		},
+		"basic cross product": {
+			vector1:        numericalgo.Vector{1, 2, 3},
+			vector2:        numericalgo.Vector{4, 5, 6},
+			expectedResult: numericalgo.Vector{-3, 6, -3},
+			expectedError:  nil,
+		},
+		"wrong dimensions": {
+			vector1:        numericalgo.Vector{1, 2},
+			vector2:        numericalgo.Vector{4, 5},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Cross product is defined only for 3-dimensional vectors"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.vector1.Cross(c.vector2)
+			assert.Equal(t, c.expectedResult, result)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestVectorAngle(t *testing.T) {
+	cases := map[string]struct {
+		vector1        numericalgo.Vector
+		vector2        numericalgo.Vector
+		expectedResult float64
+		expectedError  error
+	}{
+		"orthogonal vectors": {
+			vector1:        numericalgo.Vector{1, 0},
+			vector2:        numericalgo.Vector{0, 2},
+			expectedResult: math.Pi / 2,
+			expectedError:  nil,
+		},
+		"opposite vectors": {
+			vector1:        numericalgo.Vector{1, 1},
+			vector2:        numericalgo.Vector{-2, -2},
+			expectedResult: math.Pi,
+			expectedError:  nil,
+		},
+		"nearly parallel vectors": {
+			vector1:        numericalgo.Vector{1, 0},
+			vector2:        numericalgo.Vector{1, 1e-10},
+			expectedResult: 1e-10,
+			expectedError:  nil,
+		},
+		"zero vector": {
+			vector1:        numericalgo.Vector{1, 0},
+			vector2:        numericalgo.Vector{0, 0},
+			expectedResult: 0,
+			expectedError:  fmt.Errorf("Cannot normalize zero vector"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.vector1.Angle(c.vector2)
+			assert.InDelta(t, c.expectedResult, result, 1e-12*c.expectedResult)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestVectorProject(t *testing.T) {
+	cases := map[string]struct {
+		vector1        numericalgo.Vector
+		vector2        numericalgo.Vector
+		expectedResult numericalgo.Vector
+		expectedError  error
+	}{
+		"basic projection": {
+			vector1:        numericalgo.Vector{2, 3},
+			vector2:        numericalgo.Vector{4, 0},
+			expectedResult: numericalgo.Vector{2, 0},
+			expectedError:  nil,
+		},
+		"projection onto zero vector": {
+			vector1:        numericalgo.Vector{2, 3},
+			vector2:        numericalgo.Vector{0, 0},
+			expectedResult: nil,
+			expectedError:  fmt.Errorf("Cannot project onto zero vector"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := c.vector1.Project(c.vector2)
+			assert.Equal(t, c.expectedResult, result)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestVectorElementWise(t *testing.T) {
+	cases := map[string]struct {
+		vector1         numericalgo.Vector
+		vector2         numericalgo.Vector
+		expectedProduct numericalgo.Vector
+		expectedRatio   numericalgo.Vector
+		expectedError   error
+	}{
+		"basic element-wise operations": {
+			vector1:         numericalgo.Vector{2, 6, 12},
+			vector2:         numericalgo.Vector{1, 2, 4},
+			expectedProduct: numericalgo.Vector{2, 12, 48},
+			expectedRatio:   numericalgo.Vector{2, 3, 3},
+			expectedError:   nil,
+		},
+		"wrong dimensions": {
+			vector1:         numericalgo.Vector{1, 2},
+			vector2:         numericalgo.Vector{1, 2, 3},
+			expectedProduct: nil,
+			expectedRatio:   nil,
+			expectedError:   fmt.Errorf("Dimensions must match"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			product, err := c.vector1.Mul(c.vector2)
+			assert.Equal(t, c.expectedProduct, product)
+			assert.Equal(t, c.expectedError, err)
+
+			ratio, err := c.vector1.Div(c.vector2)
+			assert.Equal(t, c.expectedRatio, ratio)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestVectorDivByZero(t *testing.T) {
+	result, err := numericalgo.Vector{1, 2}.Div(numericalgo.Vector{1, 0})
+	assert.Equal(t, numericalgo.Vector(nil), result)
+	assert.Equal(t, fmt.Errorf("Cannot divide by zero"), err)
+}
+
+func TestVectorApply(t *testing.T) {
+	result := numericalgo.Vector{1, 4, 9}.Apply(math.Sqrt)
+	assert.Equal(t, numericalgo.Vector{1, 2, 3}, result)
+}
+
+func TestVectorCumulative(t *testing.T) {
+	cases := map[string]struct {
+		vector          numericalgo.Vector
+		expectedSum     numericalgo.Vector
+		expectedProduct numericalgo.Vector
+	}{
+		"basic cumulative operations": {
+			vector:          numericalgo.Vector{1, 2, 3, 4},
+			expectedSum:     numericalgo.Vector{1, 3, 6, 10},
+			expectedProduct: numericalgo.Vector{1, 2, 6, 24},
+		},
+		"empty vector": {
+			vector:          numericalgo.Vector{},
+			expectedSum:     nil,
+			expectedProduct: nil,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expectedSum, c.vector.CumSum())
+			assert.Equal(t, c.expectedProduct, c.vector.CumProd())
+		})
+	}
+}
+
+func TestVectorExtremes(t *testing.T) {
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		expectedMin    float64
+		expectedMax    float64
+		expectedArgMin int
+		expectedArgMax int
+		expectedError  error
+	}{
+		"basic extremes": {
+			vector:         numericalgo.Vector{3, -1, 7, -1, 7},
+			expectedMin:    -1,
+			expectedMax:    7,
+			expectedArgMin: 1,
+			expectedArgMax: 2,
+			expectedError:  nil,
+		},
+		"empty vector": {
+			vector:         numericalgo.Vector{},
+			expectedMin:    0,
+			expectedMax:    0,
+			expectedArgMin: 0,
+			expectedArgMax: 0,
+			expectedError:  fmt.Errorf("Vector cannot be empty"),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			min, err := c.vector.Min()
+			assert.Equal(t, c.expectedMin, min)
+			assert.Equal(t, c.expectedError, err)
+
+			max, err := c.vector.Max()
+			assert.Equal(t, c.expectedMax, max)
+			assert.Equal(t, c.expectedError, err)
+
+			argMin, err := c.vector.ArgMin()
+			assert.Equal(t, c.expectedArgMin, argMin)
+			assert.Equal(t, c.expectedError, err)
+
+			argMax, err := c.vector.ArgMax()
+			assert.Equal(t, c.expectedArgMax, argMax)
+			assert.Equal(t, c.expectedError, err)
+		})
+	}
+}
+
+func TestVectorArgsort(t *testing.T) {
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		expectedResult []int
+	}{
+		"basic argsort": {
+			vector:         numericalgo.Vector{3, 1, 2},
+			expectedResult: []int{1, 2, 0},
+		},
+		"equal elements keep their order": {
+			vector:         numericalgo.Vector{2, 1, 2, 1},
+			expectedResult: []int{1, 3, 0, 2},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expectedResult, c.vector.Argsort())
+		})
+	}
+}
+
+func TestVectorUnique(t *testing.T) {
+	cases := map[string]struct {
+		vector         numericalgo.Vector
+		expectedResult numericalgo.Vector
+	}{
+		"basic unique": {
+			vector:         numericalgo.Vector{3, 1, 2, 3, 1},
+			expectedResult: numericalgo.Vector{1, 2, 3},
+		},
+		"empty vector": {
+			vector:         numericalgo.Vector{},
+			expectedResult: nil,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expectedResult, c.vector.Unique())
+		})
+	}
+}
+
+func TestVectorReverse(t *testing.T) {
+	vector := numericalgo.Vector{1, 2, 3}
+	assert.Equal(t, numericalgo.Vector{3, 2, 1}, vector.Reverse())
+	assert.Equal(t, numericalgo.Vector{1, 2, 3}, vector)
+}